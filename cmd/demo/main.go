@@ -7,14 +7,15 @@ import (
 )
 
 func main() {
-	debug := true
-	w := webview2.New(debug)
+	w := webview2.New(
+		webview2.WithDebug(true),
+		webview2.WithTitle("Minimal webview example"),
+		webview2.WithSize(800, 600, webview2.HintFixed),
+		webview2.WithInitialURL("https://en.m.wikipedia.org/wiki/Main_Page"),
+	)
 	if w == nil {
 		log.Fatalln("Failed to load webview.")
 	}
 	defer w.Destroy()
-	w.SetTitle("Minimal webview example")
-	w.SetSize(800, 600, webview2.HintFixed)
-	w.Navigate("https://en.m.wikipedia.org/wiki/Main_Page")
 	w.Run()
 }
@@ -1,5 +1,10 @@
 package webview2
 
+import (
+	"io"
+	"time"
+)
+
 // This is copied from webview/webview.
 // The documentation is included for convenience.
 
@@ -21,4 +26,484 @@ const (
 
 	// HintCenter window wants to be at the center
 	HintCenter
+
+	// HintFullscreen makes the window cover its monitor without a frame;
+	// width and height are ignored
+	HintFullscreen
+)
+
+// FindInPageOptions configures a FindInPage search.
+type FindInPageOptions struct {
+	// CaseSensitive only matches text with the same case as the query
+	CaseSensitive bool
+
+	// FindNext moves on to the next match instead of starting over from the
+	// top of the page
+	FindNext bool
+
+	// ShouldHighlightAllMatches highlights every match, not just the
+	// selected one
+	ShouldHighlightAllMatches bool
+}
+
+// PermissionState is the answer to a permission request.
+type PermissionState int
+
+const (
+	// PermissionStateDefault leaves the decision to WebView2, which usually
+	// denies the request
+	PermissionStateDefault PermissionState = iota
+
+	// PermissionStateAllow grants the permission
+	PermissionStateAllow
+
+	// PermissionStateDeny denies the permission
+	PermissionStateDeny
+)
+
+// Permission kinds passed to OnPermissionRequested handlers.
+const (
+	PermissionKindUnknown       = "unknown"
+	PermissionKindCamera        = "camera"
+	PermissionKindMicrophone    = "microphone"
+	PermissionKindGeolocation   = "geolocation"
+	PermissionKindNotifications = "notifications"
+	PermissionKindOtherSensors  = "other-sensors"
+	PermissionKindMidiSysEx     = "midi-sysex"
+	PermissionKindClipboardRead = "clipboard-read"
+)
+
+// DialogKind is the kind of JavaScript dialog a page wants to show.
+type DialogKind int
+
+const (
+	// DialogKindAlert is a window.alert() dialog
+	DialogKindAlert DialogKind = iota
+
+	// DialogKindConfirm is a window.confirm() dialog
+	DialogKindConfirm
+
+	// DialogKindPrompt is a window.prompt() dialog
+	DialogKindPrompt
+
+	// DialogKindBeforeUnload asks the user whether to leave the page
+	DialogKindBeforeUnload
+)
+
+// ContextMenuItemKind is the kind of a ContextMenuItem.
+type ContextMenuItemKind int
+
+const (
+	// ContextMenuItemCommand is a plain item that runs an action
+	ContextMenuItemCommand ContextMenuItemKind = iota
+
+	// ContextMenuItemCheckBox is an item with a check mark
+	ContextMenuItemCheckBox
+
+	// ContextMenuItemRadio is one of a group of mutually exclusive items
+	ContextMenuItemRadio
+
+	// ContextMenuItemSeparator is a line between groups of items
+	ContextMenuItemSeparator
+
+	// ContextMenuItemSubmenu opens the items in Children
+	ContextMenuItemSubmenu
+)
+
+// ContextMenuItem is an entry of a context menu.
+type ContextMenuItem struct {
+	// Label is the text shown for the item
+	Label string
+
+	// Kind is the kind of the item
+	Kind ContextMenuItemKind
+
+	// Action is called on the UI thread when the item is selected. It is
+	// only used for items created in Go, not for the default ones.
+	Action func()
+
+	// Children are the items of a submenu
+	Children []ContextMenuItem
+
+	// builtin marks the default items, which WebView2 knows by commandID
+	builtin   bool
+	commandID int32
+}
+
+// ProcessFailedKind classifies a failure reported to OnProcessFailed.
+type ProcessFailedKind int
+
+const (
+	// ProcessFailedRendererCrash means the process rendering the page
+	// crashed; the page is gone until it is navigated again
+	ProcessFailedRendererCrash ProcessFailedKind = iota
+
+	// ProcessFailedBrowserCrash means the browser process exited; the webview
+	// can not be used anymore
+	ProcessFailedBrowserCrash
+
+	// ProcessFailedGPUCrash means the GPU process crashed; WebView2 restarts
+	// it on its own
+	ProcessFailedGPUCrash
+
+	// ProcessFailedRenderProcessExited means the process rendering the page
+	// was ended, for example from the task manager
+	ProcessFailedRenderProcessExited
+
+	// ProcessFailedRendererUnresponsive means the page stopped responding to
+	// user input
+	ProcessFailedRendererUnresponsive
+
+	// ProcessFailedOther covers helper processes that failed
+	ProcessFailedOther
+)
+
+// SchemeRequest is a request for a custom scheme passed to the handler of
+// SetCustomSchemeHandler.
+type SchemeRequest struct {
+	// URL is the full URI requested, such as "app://host/index.html"
+	URL string
+
+	// Method is the HTTP method, such as "GET"
+	Method string
+
+	// Headers are the request headers
+	Headers map[string]string
+
+	// Body is the request content; it is empty for most GET requests
+	Body io.Reader
+}
+
+// SchemeResponse is the answer of a SetCustomSchemeHandler handler.
+type SchemeResponse struct {
+	// StatusCode is the HTTP status; 0 means 200
+	StatusCode int
+
+	// Headers are the response headers, such as "Content-Type"
+	Headers map[string]string
+
+	// Body is the response content; it may be nil
+	Body io.Reader
+}
+
+// AccessKind controls which pages may load resources from a folder mapped
+// with SetVirtualHostMapping.
+type AccessKind int
+
+const (
+	// AccessKindDenyLocalSubdomains lets only pages of the virtual host
+	// itself load its resources
+	AccessKindDenyLocalSubdomains AccessKind = iota
+
+	// AccessKindAllowLocalSubdomains lets any page load the resources of the
+	// virtual host
+	AccessKindAllowLocalSubdomains
+
+	// AccessKindDenyRemoteOrigins is like AccessKindAllowLocalSubdomains, but
+	// pages of other origins may not fetch them with CORS
+	AccessKindDenyRemoteOrigins
+)
+
+// ResourceRequest is a request of the page passed to the handler of
+// OnWebResourceRequested.
+type ResourceRequest struct {
+	// URL is the URI requested
+	URL string
+
+	// Method is the HTTP method, such as "GET"
+	Method string
+
+	// Headers are the request headers
+	Headers map[string]string
+
+	// Body is the request content; it is nil for most GET requests
+	Body []byte
+
+	// Response answers the request without sending it when set by the
+	// handler
+	Response *ResourceResponse
+}
+
+// ResourceResponse is a response returned in ResourceRequest.Response.
+type ResourceResponse struct {
+	// StatusCode is the HTTP status; 0 means 200
+	StatusCode int
+
+	// Headers are the response headers, such as "Content-Type"
+	Headers map[string]string
+
+	// Body is the response content
+	Body []byte
+}
+
+// DataKind is a set of kinds of browsing data for ClearBrowserData.
+type DataKind int
+
+const (
+	// DataKindCookies are the cookies of all sites
+	DataKindCookies DataKind = 1 << iota
+
+	// DataKindFileCache is the HTTP disk cache and the Cache API storage
+	DataKindFileCache
+
+	// DataKindLocalStorage is the localStorage of all sites
+	DataKindLocalStorage
+
+	// DataKindAllDOMStorage is all DOM storage of all sites: local storage,
+	// IndexedDB, WebSQL, file systems and the cache storage. WebView2 has no
+	// kind for session storage alone
+	DataKindAllDOMStorage
+
+	// DataKindIndexedDB are the IndexedDB databases of all sites
+	DataKindIndexedDB
+
+	// DataKindFormData is the autofill data saved from forms
+	DataKindFormData
+
+	// DataKindPasswords are the saved passwords
+	DataKindPasswords
+
+	// DataKindAll is all of the browsing data of the profile
+	DataKindAll DataKind = -1
+
+	// DataKindSessionStorage is another name for DataKindAllDOMStorage. It
+	// clears all DOM storage, not just session storage, which WebView2
+	// cannot clear on its own
+	DataKindSessionStorage = DataKindAllDOMStorage
+)
+
+// PrintOrientation is the page orientation of PDFPrintSettings.
+type PrintOrientation int
+
+const (
+	// PrintPortrait prints pages taller than they are wide
+	PrintPortrait PrintOrientation = iota
+
+	// PrintLandscape prints pages wider than they are tall
+	PrintLandscape
+)
+
+// PDFPrintSettings configures PrintToPDF. Sizes and margins are in inches.
+type PDFPrintSettings struct {
+	Orientation PrintOrientation
+
+	// PageWidth and PageHeight are the paper size; zero keeps the default
+	// of 8.5 by 11 inches
+	PageWidth  float64
+	PageHeight float64
+
+	// The margins are used as given, so zero prints to the edge of the page
+	MarginTop    float64
+	MarginBottom float64
+	MarginLeft   float64
+	MarginRight  float64
+
+	// PrintBackgrounds includes background colors and images
+	PrintBackgrounds bool
+
+	// HeaderFooter adds the title, URL, date and page numbers
+	HeaderFooter bool
+}
+
+// DuplexKind selects printing on one or both sides of the paper.
+type DuplexKind int
+
+const (
+	// DuplexDefault uses the setting of the printer
+	DuplexDefault DuplexKind = iota
+
+	// DuplexOneSided prints on one side only
+	DuplexOneSided
+
+	// DuplexLongEdge prints on both sides, flipped on the long edge
+	DuplexLongEdge
+
+	// DuplexShortEdge prints on both sides, flipped on the short edge
+	DuplexShortEdge
+)
+
+// ColorModeKind selects printing in color or grayscale.
+type ColorModeKind int
+
+const (
+	// ColorModeDefault uses the setting of the printer
+	ColorModeDefault ColorModeKind = iota
+
+	// ColorModeColor prints in color
+	ColorModeColor
+
+	// ColorModeGrayscale prints in shades of gray
+	ColorModeGrayscale
+)
+
+// PrintSettings configures Print.
+type PrintSettings struct {
+	// PrinterName is the printer to use; empty means the default printer
+	PrinterName string
+
+	// Copies is the number of copies; zero prints one
+	Copies int
+
+	Duplex    DuplexKind
+	ColorMode ColorModeKind
+
+	// Collation prints whole copies one after the other
+	Collation bool
+
+	// StartPage and EndPage select an inclusive range of pages, counted
+	// from 1. A zero StartPage prints all pages and a zero EndPage prints up
+	// to the last one.
+	StartPage, EndPage int
+}
+
+// ConsoleLevel is the severity of a message a page writes to the console.
+type ConsoleLevel int
+
+const (
+	// ConsoleLevelLog is a console.log() message
+	ConsoleLevelLog ConsoleLevel = iota
+
+	// ConsoleLevelInfo is a console.info() message
+	ConsoleLevelInfo
+
+	// ConsoleLevelWarning is a console.warn() message
+	ConsoleLevelWarning
+
+	// ConsoleLevelError is a console.error() message or a failed
+	// console.assert()
+	ConsoleLevelError
+
+	// ConsoleLevelDebug is a console.debug() or console.trace() message
+	ConsoleLevelDebug
+)
+
+// DragRect is an area of the window, in client pixels, that moves the window
+// when it is dragged. See SetDragRegion.
+type DragRect struct {
+	X, Y, Width, Height int
+}
+
+// BackdropMaterial is a Windows 11 material drawn behind a transparent page.
+type BackdropMaterial int
+
+const (
+	// BackdropNone draws no material
+	BackdropNone BackdropMaterial = iota
+
+	// BackdropMica is the material of main windows, tinted by the desktop
+	// wallpaper
+	BackdropMica
+
+	// BackdropAcrylic is the blurred, translucent material of transient
+	// windows such as menus
+	BackdropAcrylic
+
+	// BackdropMicaAlt is the variant of Mica used behind tabbed title bars
+	BackdropMicaAlt
+)
+
+// AutoplayPolicy decides whether pages may play media with sound before the
+// user interacts with them. See WithMediaAutoplay.
+type AutoplayPolicy int
+
+const (
+	// PolicyUserGestureRequired only plays media once the user has
+	// interacted with the frame
+	PolicyUserGestureRequired AutoplayPolicy = iota
+
+	// PolicyNoUserGestureRequired lets pages play media right away, as kiosk
+	// and media applications usually want
+	PolicyNoUserGestureRequired
+
+	// PolicyDocumentUserActivationRequired plays media once the user has
+	// interacted with the document or one of its frames
+	PolicyDocumentUserActivationRequired
+)
+
+// KeyModifier is a set of modifier keys held down during a simulated key
+// press. See SimulateKeyPress.
+type KeyModifier int
+
+const (
+	// ModShift holds the Shift key
+	ModShift KeyModifier = 1 << iota
+
+	// ModCtrl holds the Ctrl key
+	ModCtrl
+
+	// ModAlt holds the Alt key
+	ModAlt
+
+	// ModMeta holds the Windows key
+	ModMeta
+)
+
+// MouseButton is a mouse button pressed by SimulateMouseClick.
+type MouseButton int
+
+const (
+	// MouseButtonLeft is the primary button
+	MouseButtonLeft MouseButton = iota
+
+	// MouseButtonRight is the secondary button, which usually opens the
+	// context menu
+	MouseButtonRight
+
+	// MouseButtonMiddle is the wheel button
+	MouseButtonMiddle
+)
+
+// SameSite is the SameSite attribute of a Cookie.
+type SameSite int
+
+const (
+	// SameSiteNone sends the cookie with cross-site requests
+	SameSiteNone SameSite = iota
+
+	// SameSiteLax sends the cookie with top-level cross-site navigations
+	SameSiteLax
+
+	// SameSiteStrict only sends the cookie with same-site requests
+	SameSiteStrict
+)
+
+// Cookie is an HTTP cookie of the webview's profile, as used by GetCookies
+// and SetCookie.
+type Cookie struct {
+	// Name and Value are the name and value of the cookie
+	Name, Value string
+
+	// Domain is the host the cookie belongs to, such as "example.com"
+	Domain string
+
+	// Path limits the cookie to URLs under it, such as "/"
+	Path string
+
+	// Secure only sends the cookie over HTTPS
+	Secure bool
+
+	// HTTPOnly hides the cookie from scripts
+	HTTPOnly bool
+
+	// SameSite limits sending the cookie with cross-site requests
+	SameSite SameSite
+
+	// Expires is when the cookie expires; the zero time means a session
+	// cookie
+	Expires time.Time
+}
+
+// ColorScheme is the color scheme pages are asked to use through the
+// prefers-color-scheme media query. See SetPreferredColorScheme.
+type ColorScheme int
+
+const (
+	// ColorSchemeAuto follows the app mode setting of Windows
+	ColorSchemeAuto ColorScheme = iota
+
+	// ColorSchemeLight asks for a light theme
+	ColorSchemeLight
+
+	// ColorSchemeDark asks for a dark theme
+	ColorSchemeDark
 )
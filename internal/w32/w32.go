@@ -11,6 +11,7 @@ import (
 var (
 	ole32               = windows.NewLazySystemDLL("ole32")
 	Ole32CoInitializeEx = ole32.NewProc("CoInitializeEx")
+	Ole32CoTaskMemAlloc = ole32.NewProc("CoTaskMemAlloc")
 
 	kernel32                   = windows.NewLazySystemDLL("kernel32")
 	Kernel32GetCurrentThreadID = kernel32.NewProc("GetCurrentThreadId")
@@ -41,6 +42,34 @@ var (
 	User32SetWindowPos       = user32.NewProc("SetWindowPos")
 	User32MoveWindow         = user32.NewProc("MoveWindow")
 	User32GetWindowRect      = user32.NewProc("GetWindowRect")
+	User32MessageBoxW        = user32.NewProc("MessageBoxW")
+	User32SetWinEventHook    = user32.NewProc("SetWinEventHook")
+	User32UnhookWinEvent     = user32.NewProc("UnhookWinEvent")
+	User32GetWindowTextW     = user32.NewProc("GetWindowTextW")
+	User32GetAncestor        = user32.NewProc("GetAncestor")
+	User32MonitorFromWindow  = user32.NewProc("MonitorFromWindow")
+	User32GetMonitorInfoW    = user32.NewProc("GetMonitorInfoW")
+	User32IsZoomed           = user32.NewProc("IsZoomed")
+	User32ScreenToClient     = user32.NewProc("ScreenToClient")
+	User32SendMessageW       = user32.NewProc("SendMessageW")
+	User32DestroyIcon        = user32.NewProc("DestroyIcon")
+	User32PostMessageW       = user32.NewProc("PostMessageW")
+	User32MapVirtualKeyW     = user32.NewProc("MapVirtualKeyW")
+	User32GetWindow          = user32.NewProc("GetWindow")
+	User32MapWindowPoints    = user32.NewProc("MapWindowPoints")
+	User32GetCursorPos       = user32.NewProc("GetCursorPos")
+	User32ReleaseCapture     = user32.NewProc("ReleaseCapture")
+
+	User32CreateIconFromResourceEx = user32.NewProc("CreateIconFromResourceEx")
+
+	User32SetLayeredWindowAttributes = user32.NewProc("SetLayeredWindowAttributes")
+	User32GetLayeredWindowAttributes = user32.NewProc("GetLayeredWindowAttributes")
+	User32GetWindowTextLengthW       = user32.NewProc("GetWindowTextLengthW")
+
+	dwmapi                             = windows.NewLazySystemDLL("dwmapi")
+	DwmapiDwmSetWindowAttribute        = dwmapi.NewProc("DwmSetWindowAttribute")
+	DwmapiDwmExtendFrameIntoClientArea = dwmapi.NewProc("DwmExtendFrameIntoClientArea")
+	DwmapiDwmGetColorizationColor      = dwmapi.NewProc("DwmGetColorizationColor")
 
 	shell32           = windows.NewLazySystemDLL("shell32")
 	User32ExtractIcon = shell32.NewProc("ExtractIconW")
@@ -51,13 +80,29 @@ const (
 	SystemMetricsCyScreen = 1
 	SystemMetricsCxIcon   = 11
 	SystemMetricsCyIcon   = 12
+	SystemMetricsCxSmIcon = 49
+	SystemMetricsCySmIcon = 50
 )
 
 const (
-	SWShow = 5
+	ImageIcon = 1
+
+	LRLoadFromFile = 0x00000010
+
+	ICONSmall = 0
+	ICONBig   = 1
 )
 
 const (
+	SWHide     = 0
+	SWMaximize = 3
+	SWShow     = 5
+	SWMinimize = 6
+	SWRestore  = 9
+)
+
+const (
+	SWPNoSize       = 0x0001
 	SWPNoZOrder     = 0x0004
 	SWPNoActivate   = 0x0010
 	SWPNoMove       = 0x0002
@@ -66,19 +111,97 @@ const (
 
 const (
 	WMDestroy       = 0x0002
+	WMMove          = 0x0003
 	WMSize          = 0x0005
+	WMActivate      = 0x0006
 	WMClose         = 0x0010
+	WMSettingChange = 0x001A
 	WMQuit          = 0x0012
 	WMGetMinMaxInfo = 0x0024
+	WMSetIcon       = 0x0080
+	WMNCHitTest     = 0x0084
+	WMNCLButtonDown = 0x00A1
+	WMKeyDown       = 0x0100
+	WMKeyUp         = 0x0101
+	WMChar          = 0x0102
+	WMSysKeyDown    = 0x0104
+	WMSysKeyUp      = 0x0105
+	WMMouseMove     = 0x0200
+	WMLButtonDown   = 0x0201
+	WMLButtonUp     = 0x0202
+	WMRButtonDown   = 0x0204
+	WMRButtonUp     = 0x0205
+	WMMButtonDown   = 0x0207
+	WMMButtonUp     = 0x0208
+	WMDPIChanged    = 0x02E0
 	WMApp           = 0x8000
 )
 
 const (
-	GWLStyle = -16
+	GWLStyle   = -16
+	GWLExStyle = -20
+)
+
+const (
+	HWNDTopmost   = ^uintptr(0) // (HWND)-1
+	HWNDNoTopmost = ^uintptr(1) // (HWND)-2
+)
+
+const (
+	GARoot = 2
+)
+
+const (
+	GWChild = 5
+)
+
+const (
+	VKShift   = 0x10
+	VKControl = 0x11
+	VKMenu    = 0x12
+	VKLWin    = 0x5B
+)
+
+const (
+	MapVKVKToVSC = 0
+)
+
+const (
+	MKLButton = 0x0001
+	MKRButton = 0x0002
+	MKMButton = 0x0010
+)
+
+const (
+	WAInactive = 0
+)
+
+const (
+	HTClient  = 1
+	HTCaption = 2
+)
+
+const (
+	MonitorDefaultToNearest = 0x00000002
+)
+
+const (
+	EventObjectDestroy   = 0x8001
+	EventObjectShow      = 0x8002
+	WinEventOutOfContext = 0x0000
+	ObjIDWindow          = 0
+)
+
+const (
+	MBOk          = 0x00000000
+	MBOkCancel    = 0x00000001
+	MBIconWarning = 0x00000030
+	IDOk          = 1
 )
 
 const (
 	WSOverlapped       = 0x00000000
+	WSPopup            = 0x80000000
 	WSMaximizeBox      = 0x00020000
 	WSThickFrame       = 0x00040000
 	WSCaption          = 0x00C00000
@@ -87,6 +210,28 @@ const (
 	WSOverlappedWindow = (WSOverlapped | WSCaption | WSSysMenu | WSThickFrame | WSMinimizeBox | WSMaximizeBox)
 )
 
+const (
+	WSExTopmost = 0x00000008
+	WSExLayered = 0x00080000
+)
+
+const (
+	LWAAlpha = 0x00000002
+)
+
+const (
+	DWMWAUseImmersiveDarkModeBefore20H1 = 19
+	DWMWAUseImmersiveDarkMode           = 20
+	DWMWASystemBackdropType             = 38
+)
+
+const (
+	DWMSBTNone            = 1
+	DWMSBTMainWindow      = 2
+	DWMSBTTransientWindow = 3
+	DWMSBTTabbedWindow    = 4
+)
+
 type WndClassExW struct {
 	CbSize        uint32
 	Style         uint32
@@ -121,6 +266,20 @@ type Point struct {
 	X, Y int32
 }
 
+type Margins struct {
+	CxLeftWidth    int32
+	CxRightWidth   int32
+	CyTopHeight    int32
+	CyBottomHeight int32
+}
+
+type MonitorInfo struct {
+	CbSize    uint32
+	RcMonitor Rect
+	RcWork    Rect
+	DwFlags   uint32
+}
+
 type Msg struct {
 	Hwnd     syscall.Handle
 	Message  uint32
@@ -147,8 +306,12 @@ func Utf16PtrToString(p *uint16) string {
 }
 
 func SHCreateMemStream(data []byte) (uintptr, error) {
+	var p uintptr
+	if len(data) > 0 {
+		p = uintptr(unsafe.Pointer(&data[0]))
+	}
 	ret, _, err := shlwapiSHCreateMemStream.Call(
-		uintptr(unsafe.Pointer(&data[0])),
+		p,
 		uintptr(len(data)),
 	)
 	if ret == 0 {
@@ -167,3 +330,29 @@ func ExtractIcon(exeFileName string, iconIndex int32) uintptr {
 	)
 	return ret
 }
+
+// GetMonitorRect returns the bounds of the monitor that most of the window
+// hwnd is on, in virtual screen coordinates.
+func GetMonitorRect(hwnd uintptr) (Rect, bool) {
+	monitor, _, _ := User32MonitorFromWindow.Call(hwnd, MonitorDefaultToNearest)
+	info := MonitorInfo{}
+	info.CbSize = uint32(unsafe.Sizeof(info))
+	ret, _, _ := User32GetMonitorInfoW.Call(monitor, uintptr(unsafe.Pointer(&info)))
+	if ret == 0 {
+		return Rect{}, false
+	}
+	return info.RcMonitor, true
+}
+
+// IsWindowsVersionAtLeast reports whether the running Windows is at least
+// major.minor.build.
+func IsWindowsVersionAtLeast(major, minor, build uint32) bool {
+	v := windows.RtlGetVersion()
+	if v.MajorVersion != major {
+		return v.MajorVersion > major
+	}
+	if v.MinorVersion != minor {
+		return v.MinorVersion > minor
+	}
+	return v.BuildNumber >= build
+}
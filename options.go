@@ -0,0 +1,156 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"unsafe"
+
+	"github.com/project-vrcat/go-webview2/pkg/edge"
+)
+
+// webViewConfig collects the settings applied by WebViewOptions.
+type webViewConfig struct {
+	debug          bool
+	userDataFolder string
+	initialURL     string
+	title          string
+	width, height  int
+	hint           Hint
+	windowClass    string
+	parent         unsafe.Pointer
+	environment    *edge.SharedEnvironment
+	incognito      bool
+	background     *edge.COREWEBVIEW2_COLOR
+	envOptions     edge.EnvironmentOptions
+}
+
+func newWebViewConfig(opts []WebViewOption) *webViewConfig {
+	config := &webViewConfig{
+		windowClass: "webview",
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	return config
+}
+
+// WebViewOption configures a webview created with New or NewWindow.
+type WebViewOption func(*webViewConfig)
+
+// WithDebug enables the developer tools when debug is true.
+func WithDebug(debug bool) WebViewOption {
+	return func(c *webViewConfig) {
+		c.debug = debug
+	}
+}
+
+// WithUserDataFolder sets the folder WebView2 keeps its profile in. It
+// defaults to a folder named after the executable in %AppData%.
+func WithUserDataFolder(path string) WebViewOption {
+	return func(c *webViewConfig) {
+		c.userDataFolder = path
+	}
+}
+
+// WithInitialURL navigates to url once the webview is ready.
+func WithInitialURL(url string) WebViewOption {
+	return func(c *webViewConfig) {
+		c.initialURL = url
+	}
+}
+
+// WithTitle sets the window title.
+func WithTitle(title string) WebViewOption {
+	return func(c *webViewConfig) {
+		c.title = title
+	}
+}
+
+// WithSize sets the window size, as SetSize does.
+func WithSize(width, height int, hint Hint) WebViewOption {
+	return func(c *webViewConfig) {
+		c.width = width
+		c.height = height
+		c.hint = hint
+	}
+}
+
+// WithWindowClass sets the name of the window class registered for the
+// window. It defaults to "webview".
+func WithWindowClass(className string) WebViewOption {
+	return func(c *webViewConfig) {
+		c.windowClass = className
+	}
+}
+
+// WithParentWindow makes the window an owned window of parent, a HWND. The
+// window stays above its owner and is destroyed with it.
+func WithParentWindow(parent unsafe.Pointer) WebViewOption {
+	return func(c *webViewConfig) {
+		c.parent = parent
+	}
+}
+
+// WithSharedEnvironment creates the webview in env instead of a new WebView2
+// environment. Webviews sharing an environment share one browser process and
+// its user data folder, so WithUserDataFolder has no effect.
+func WithSharedEnvironment(env *edge.SharedEnvironment) WebViewOption {
+	return func(c *webViewConfig) {
+		c.environment = env
+	}
+}
+
+// WithIncognitoProfile creates the webview in an InPrivate profile that keeps
+// no cookies, history or cache. Unless WithUserDataFolder is given, the user
+// data goes to a temporary folder that is removed once Run returns after
+// Terminate.
+func WithIncognitoProfile() WebViewOption {
+	return func(c *webViewConfig) {
+		c.incognito = true
+	}
+}
+
+// WithCustomScheme registers scheme, such as "app", so that the webview can
+// serve it with SetCustomSchemeHandler. Pages of the scheme are treated as
+// secure and their URIs have a host, as in app://host/index.html.
+func WithCustomScheme(scheme string) WebViewOption {
+	return func(c *webViewConfig) {
+		c.envOptions.CustomSchemeRegistrations = append(c.envOptions.CustomSchemeRegistrations, edge.CustomSchemeRegistration{
+			SchemeName:            scheme,
+			TreatAsSecure:         true,
+			HasAuthorityComponent: true,
+		})
+	}
+}
+
+// WithBackgroundColor sets the background color of the webview, as
+// SetBackgroundColor does, before anything is shown in it.
+func WithBackgroundColor(r, g, b, a uint8) WebViewOption {
+	return func(c *webViewConfig) {
+		c.background = &edge.COREWEBVIEW2_COLOR{R: r, G: g, B: b, A: a}
+	}
+}
+
+// WithMediaAutoplay sets the autoplay policy for media in the webview. The
+// policy is a browser argument, so it can only be chosen before the WebView2
+// environment is created: there is no setting to change it later, and it has
+// no effect together with WithSharedEnvironment, whose environment already
+// exists. Use edge.EnvironmentOptions.SetMediaAutoplay to set it for a shared
+// environment.
+func WithMediaAutoplay(policy AutoplayPolicy) WebViewOption {
+	return func(c *webViewConfig) {
+		c.envOptions.SetMediaAutoplay(edge.AutoplayPolicy(policy))
+	}
+}
+
+// WithoutHardwareAcceleration renders the webview in software, as
+// EnvironmentOptions.DisableHardwareAcceleration does. This is much slower,
+// but avoids crashes in virtual machines, remote desktop sessions and with
+// broken GPU drivers. Like WithMediaAutoplay, it has no effect together with
+// WithSharedEnvironment.
+func WithoutHardwareAcceleration() WebViewOption {
+	return func(c *webViewConfig) {
+		c.envOptions.DisableHardwareAcceleration = true
+	}
+}
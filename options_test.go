@@ -0,0 +1,117 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"reflect"
+	"testing"
+	"unsafe"
+
+	"github.com/project-vrcat/go-webview2/pkg/edge"
+)
+
+func TestNewWebViewConfigDefaults(t *testing.T) {
+	got := newWebViewConfig(nil)
+	want := &webViewConfig{windowClass: "webview"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("newWebViewConfig(nil) = %+v, want %+v", got, want)
+	}
+}
+
+func TestWebViewOptions(t *testing.T) {
+	var parent int
+	env := &edge.SharedEnvironment{}
+	tests := []struct {
+		name   string
+		opts   []WebViewOption
+		change func(c *webViewConfig)
+	}{
+		{"WithDebug", []WebViewOption{WithDebug(true)}, func(c *webViewConfig) {
+			c.debug = true
+		}},
+		{"WithUserDataFolder", []WebViewOption{WithUserDataFolder(`C:\data`)}, func(c *webViewConfig) {
+			c.userDataFolder = `C:\data`
+		}},
+		{"WithInitialURL", []WebViewOption{WithInitialURL("https://example.com/")}, func(c *webViewConfig) {
+			c.initialURL = "https://example.com/"
+		}},
+		{"WithTitle", []WebViewOption{WithTitle("title")}, func(c *webViewConfig) {
+			c.title = "title"
+		}},
+		{"WithSize", []WebViewOption{WithSize(800, 600, HintFixed)}, func(c *webViewConfig) {
+			c.width, c.height, c.hint = 800, 600, HintFixed
+		}},
+		{"WithWindowClass", []WebViewOption{WithWindowClass("app")}, func(c *webViewConfig) {
+			c.windowClass = "app"
+		}},
+		{"WithParentWindow", []WebViewOption{WithParentWindow(unsafe.Pointer(&parent))}, func(c *webViewConfig) {
+			c.parent = unsafe.Pointer(&parent)
+		}},
+		{"WithSharedEnvironment", []WebViewOption{WithSharedEnvironment(env)}, func(c *webViewConfig) {
+			c.environment = env
+		}},
+		{"WithIncognitoProfile", []WebViewOption{WithIncognitoProfile()}, func(c *webViewConfig) {
+			c.incognito = true
+		}},
+		{"WithCustomScheme", []WebViewOption{WithCustomScheme("app"), WithCustomScheme("res")}, func(c *webViewConfig) {
+			c.envOptions.CustomSchemeRegistrations = []edge.CustomSchemeRegistration{
+				{SchemeName: "app", TreatAsSecure: true, HasAuthorityComponent: true},
+				{SchemeName: "res", TreatAsSecure: true, HasAuthorityComponent: true},
+			}
+		}},
+		{"WithBackgroundColor", []WebViewOption{WithBackgroundColor(1, 2, 3, 0)}, func(c *webViewConfig) {
+			c.background = &edge.COREWEBVIEW2_COLOR{R: 1, G: 2, B: 3, A: 0}
+		}},
+		{"WithMediaAutoplay", []WebViewOption{WithMediaAutoplay(PolicyNoUserGestureRequired)}, func(c *webViewConfig) {
+			c.envOptions.AdditionalBrowserArguments = []string{"--autoplay-policy=no-user-gesture-required"}
+		}},
+		{"WithoutHardwareAcceleration", []WebViewOption{WithoutHardwareAcceleration()}, func(c *webViewConfig) {
+			c.envOptions.DisableHardwareAcceleration = true
+		}},
+		{"later options win", []WebViewOption{WithTitle("first"), WithTitle("second")}, func(c *webViewConfig) {
+			c.title = "second"
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := newWebViewConfig(nil)
+			tt.change(want)
+			if got := newWebViewConfig(tt.opts); !reflect.DeepEqual(got, want) {
+				t.Errorf("got %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestCompatConfig(t *testing.T) {
+	var window int
+	tests := []struct {
+		name           string
+		debug          bool
+		window         unsafe.Pointer
+		userDataFolder []string
+		want           *webViewConfig
+	}{
+		{"NewCompat", false, nil, nil, &webViewConfig{windowClass: "webview"}},
+		{"debug", true, nil, nil, &webViewConfig{windowClass: "webview", debug: true}},
+		{"user data folder", false, nil, []string{`C:\data`, `C:\ignored`}, &webViewConfig{
+			windowClass:    "webview",
+			userDataFolder: `C:\data`,
+		}},
+		{"NewWindowCompat", true, unsafe.Pointer(&window), []string{`C:\data`}, &webViewConfig{
+			windowClass:    "webview",
+			debug:          true,
+			userDataFolder: `C:\data`,
+			parent:         unsafe.Pointer(&window),
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := compatConfig(tt.debug, tt.window, tt.userDataFolder)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("compatConfig() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package edge
+
+type COREWEBVIEW2_PERMISSION_STATE uint32
+
+const (
+	COREWEBVIEW2_PERMISSION_STATE_DEFAULT = 0
+	COREWEBVIEW2_PERMISSION_STATE_ALLOW   = 1
+	COREWEBVIEW2_PERMISSION_STATE_DENY    = 2
+)
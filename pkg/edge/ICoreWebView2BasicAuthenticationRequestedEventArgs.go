@@ -0,0 +1,92 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2BasicAuthenticationRequestedEventArgsVtbl struct {
+	_IUnknownVtbl
+	GetUri       ComProc
+	GetChallenge ComProc
+	GetResponse  ComProc
+	GetCancel    ComProc
+	PutCancel    ComProc
+	GetDeferral  ComProc
+}
+
+type ICoreWebView2BasicAuthenticationRequestedEventArgs struct {
+	vtbl *_ICoreWebView2BasicAuthenticationRequestedEventArgsVtbl
+}
+
+func (i *ICoreWebView2BasicAuthenticationRequestedEventArgs) AddRef() {
+	addRef(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2BasicAuthenticationRequestedEventArgs) Release() {
+	release(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2BasicAuthenticationRequestedEventArgs) GetUri() (string, error) {
+	return i.getString(i.vtbl.GetUri)
+}
+
+// GetChallenge returns the WWW-Authenticate header of the response, such as
+// `Basic realm="Intranet"`.
+func (i *ICoreWebView2BasicAuthenticationRequestedEventArgs) GetChallenge() (string, error) {
+	return i.getString(i.vtbl.GetChallenge)
+}
+
+func (i *ICoreWebView2BasicAuthenticationRequestedEventArgs) GetResponse() (*ICoreWebView2BasicAuthenticationResponse, error) {
+	var err error
+	var response *ICoreWebView2BasicAuthenticationResponse
+	_, _, err = i.vtbl.GetResponse.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&response)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (i *ICoreWebView2BasicAuthenticationRequestedEventArgs) PutCancel(cancel bool) error {
+	var err error
+	_, _, err = i.vtbl.PutCancel.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(cancel)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2BasicAuthenticationRequestedEventArgs) GetDeferral() (*ICoreWebView2Deferral, error) {
+	var err error
+	var deferral *ICoreWebView2Deferral
+	_, _, err = i.vtbl.GetDeferral.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&deferral)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	return deferral, nil
+}
+
+func (i *ICoreWebView2BasicAuthenticationRequestedEventArgs) getString(proc ComProc) (string, error) {
+	var err error
+	var _value *uint16
+	_, _, err = proc.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	value := windows.UTF16PtrToString(_value)
+	windows.CoTaskMemFree(unsafe.Pointer(_value))
+	return value, nil
+}
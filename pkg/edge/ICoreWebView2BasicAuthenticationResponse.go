@@ -0,0 +1,46 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2BasicAuthenticationResponseVtbl struct {
+	_IUnknownVtbl
+	GetUserName ComProc
+	PutUserName ComProc
+	GetPassword ComProc
+	PutPassword ComProc
+}
+
+type ICoreWebView2BasicAuthenticationResponse struct {
+	vtbl *_ICoreWebView2BasicAuthenticationResponseVtbl
+}
+
+func (i *ICoreWebView2BasicAuthenticationResponse) Release() {
+	release(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2BasicAuthenticationResponse) PutUserName(userName string) error {
+	return i.putString(i.vtbl.PutUserName, userName)
+}
+
+func (i *ICoreWebView2BasicAuthenticationResponse) PutPassword(password string) error {
+	return i.putString(i.vtbl.PutPassword, password)
+}
+
+func (i *ICoreWebView2BasicAuthenticationResponse) putString(proc ComProc, value string) error {
+	_value, err := windows.UTF16PtrFromString(value)
+	if err != nil {
+		return err
+	}
+	_, _, err = proc.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
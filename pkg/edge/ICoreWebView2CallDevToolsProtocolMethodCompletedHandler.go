@@ -0,0 +1,53 @@
+package edge
+
+import (
+	"unsafe"
+
+	"github.com/project-vrcat/go-webview2/internal/w32"
+)
+
+type _ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerVtbl struct {
+	_IUnknownVtbl
+	Invoke ComProc
+}
+
+type iCoreWebView2CallDevToolsProtocolMethodCompletedHandler struct {
+	vtbl     *_ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerVtbl
+	callback func(errorCode uintptr, result string)
+}
+
+func _ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerIUnknownQueryInterface(this *iCoreWebView2CallDevToolsProtocolMethodCompletedHandler, refiid, object uintptr) uintptr {
+	return 0
+}
+
+func _ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerIUnknownAddRef(this *iCoreWebView2CallDevToolsProtocolMethodCompletedHandler) uintptr {
+	return 1
+}
+
+func _ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerIUnknownRelease(this *iCoreWebView2CallDevToolsProtocolMethodCompletedHandler) uintptr {
+	return 1
+}
+
+func _ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerInvoke(this *iCoreWebView2CallDevToolsProtocolMethodCompletedHandler, errorCode uintptr, result *uint16) uintptr {
+	unpin(unsafe.Pointer(this))
+	this.callback(errorCode, w32.Utf16PtrToString(result))
+	return 0
+}
+
+var _ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerFn = _ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerVtbl{
+	_IUnknownVtbl{
+		NewComProc(_ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerIUnknownQueryInterface),
+		NewComProc(_ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerIUnknownAddRef),
+		NewComProc(_ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerIUnknownRelease),
+	},
+	NewComProc(_ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerInvoke),
+}
+
+func newICoreWebView2CallDevToolsProtocolMethodCompletedHandler(callback func(errorCode uintptr, result string)) *iCoreWebView2CallDevToolsProtocolMethodCompletedHandler {
+	h := &iCoreWebView2CallDevToolsProtocolMethodCompletedHandler{
+		vtbl:     &_ICoreWebView2CallDevToolsProtocolMethodCompletedHandlerFn,
+		callback: callback,
+	}
+	pin(unsafe.Pointer(h))
+	return h
+}
@@ -0,0 +1,51 @@
+package edge
+
+import (
+	"unsafe"
+)
+
+type _ICoreWebView2CapturePreviewCompletedHandlerVtbl struct {
+	_IUnknownVtbl
+	Invoke ComProc
+}
+
+type iCoreWebView2CapturePreviewCompletedHandler struct {
+	vtbl     *_ICoreWebView2CapturePreviewCompletedHandlerVtbl
+	callback func(errorCode uintptr)
+}
+
+func _ICoreWebView2CapturePreviewCompletedHandlerIUnknownQueryInterface(this *iCoreWebView2CapturePreviewCompletedHandler, refiid, object uintptr) uintptr {
+	return 0
+}
+
+func _ICoreWebView2CapturePreviewCompletedHandlerIUnknownAddRef(this *iCoreWebView2CapturePreviewCompletedHandler) uintptr {
+	return 1
+}
+
+func _ICoreWebView2CapturePreviewCompletedHandlerIUnknownRelease(this *iCoreWebView2CapturePreviewCompletedHandler) uintptr {
+	return 1
+}
+
+func _ICoreWebView2CapturePreviewCompletedHandlerInvoke(this *iCoreWebView2CapturePreviewCompletedHandler, errorCode uintptr) uintptr {
+	unpin(unsafe.Pointer(this))
+	this.callback(errorCode)
+	return 0
+}
+
+var _ICoreWebView2CapturePreviewCompletedHandlerFn = _ICoreWebView2CapturePreviewCompletedHandlerVtbl{
+	_IUnknownVtbl{
+		NewComProc(_ICoreWebView2CapturePreviewCompletedHandlerIUnknownQueryInterface),
+		NewComProc(_ICoreWebView2CapturePreviewCompletedHandlerIUnknownAddRef),
+		NewComProc(_ICoreWebView2CapturePreviewCompletedHandlerIUnknownRelease),
+	},
+	NewComProc(_ICoreWebView2CapturePreviewCompletedHandlerInvoke),
+}
+
+func newICoreWebView2CapturePreviewCompletedHandler(callback func(errorCode uintptr)) *iCoreWebView2CapturePreviewCompletedHandler {
+	h := &iCoreWebView2CapturePreviewCompletedHandler{
+		vtbl:     &_ICoreWebView2CapturePreviewCompletedHandlerFn,
+		callback: callback,
+	}
+	pin(unsafe.Pointer(h))
+	return h
+}
@@ -0,0 +1,51 @@
+package edge
+
+import (
+	"unsafe"
+)
+
+type _ICoreWebView2ClearBrowsingDataCompletedHandlerVtbl struct {
+	_IUnknownVtbl
+	Invoke ComProc
+}
+
+type iCoreWebView2ClearBrowsingDataCompletedHandler struct {
+	vtbl     *_ICoreWebView2ClearBrowsingDataCompletedHandlerVtbl
+	callback func(errorCode uintptr)
+}
+
+func _ICoreWebView2ClearBrowsingDataCompletedHandlerIUnknownQueryInterface(this *iCoreWebView2ClearBrowsingDataCompletedHandler, refiid, object uintptr) uintptr {
+	return 0
+}
+
+func _ICoreWebView2ClearBrowsingDataCompletedHandlerIUnknownAddRef(this *iCoreWebView2ClearBrowsingDataCompletedHandler) uintptr {
+	return 1
+}
+
+func _ICoreWebView2ClearBrowsingDataCompletedHandlerIUnknownRelease(this *iCoreWebView2ClearBrowsingDataCompletedHandler) uintptr {
+	return 1
+}
+
+func _ICoreWebView2ClearBrowsingDataCompletedHandlerInvoke(this *iCoreWebView2ClearBrowsingDataCompletedHandler, errorCode uintptr) uintptr {
+	unpin(unsafe.Pointer(this))
+	this.callback(errorCode)
+	return 0
+}
+
+var _ICoreWebView2ClearBrowsingDataCompletedHandlerFn = _ICoreWebView2ClearBrowsingDataCompletedHandlerVtbl{
+	_IUnknownVtbl{
+		NewComProc(_ICoreWebView2ClearBrowsingDataCompletedHandlerIUnknownQueryInterface),
+		NewComProc(_ICoreWebView2ClearBrowsingDataCompletedHandlerIUnknownAddRef),
+		NewComProc(_ICoreWebView2ClearBrowsingDataCompletedHandlerIUnknownRelease),
+	},
+	NewComProc(_ICoreWebView2ClearBrowsingDataCompletedHandlerInvoke),
+}
+
+func newICoreWebView2ClearBrowsingDataCompletedHandler(callback func(errorCode uintptr)) *iCoreWebView2ClearBrowsingDataCompletedHandler {
+	h := &iCoreWebView2ClearBrowsingDataCompletedHandler{
+		vtbl:     &_ICoreWebView2ClearBrowsingDataCompletedHandlerFn,
+		callback: callback,
+	}
+	pin(unsafe.Pointer(h))
+	return h
+}
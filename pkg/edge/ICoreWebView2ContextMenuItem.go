@@ -0,0 +1,105 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2ContextMenuItemVtbl struct {
+	_IUnknownVtbl
+	GetName                   ComProc
+	GetLabel                  ComProc
+	GetCommandId              ComProc
+	GetShortcutKeyDescription ComProc
+	GetIcon                   ComProc
+	GetKind                   ComProc
+	PutIsEnabled              ComProc
+	GetIsEnabled              ComProc
+	PutIsChecked              ComProc
+	GetIsChecked              ComProc
+	GetChildren               ComProc
+	AddCustomItemSelected     ComProc
+	RemoveCustomItemSelected  ComProc
+}
+
+type ICoreWebView2ContextMenuItem struct {
+	vtbl *_ICoreWebView2ContextMenuItemVtbl
+}
+
+func (i *ICoreWebView2ContextMenuItem) AddRef() {
+	addRef(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2ContextMenuItem) Release() {
+	release(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2ContextMenuItem) GetLabel() (string, error) {
+	var err error
+	var _label *uint16
+	_, _, err = i.vtbl.GetLabel.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_label)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	label := windows.UTF16PtrToString(_label)
+	windows.CoTaskMemFree(unsafe.Pointer(_label))
+	return label, nil
+}
+
+func (i *ICoreWebView2ContextMenuItem) GetCommandId() (int32, error) {
+	var err error
+	var commandId int32
+	_, _, err = i.vtbl.GetCommandId.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&commandId)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	return commandId, nil
+}
+
+func (i *ICoreWebView2ContextMenuItem) GetKind() (COREWEBVIEW2_CONTEXT_MENU_ITEM_KIND, error) {
+	var err error
+	var kind COREWEBVIEW2_CONTEXT_MENU_ITEM_KIND
+	_, _, err = i.vtbl.GetKind.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&kind)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	return kind, nil
+}
+
+// GetChildren returns the items of a submenu, or nil for other kinds. The
+// caller must Release a non-nil result.
+func (i *ICoreWebView2ContextMenuItem) GetChildren() (*ICoreWebView2ContextMenuItemCollection, error) {
+	var err error
+	var children *ICoreWebView2ContextMenuItemCollection
+	_, _, err = i.vtbl.GetChildren.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&children)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	return children, nil
+}
+
+func (i *ICoreWebView2ContextMenuItem) AddCustomItemSelected(eventHandler *ICoreWebView2CustomItemSelectedEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddCustomItemSelected.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
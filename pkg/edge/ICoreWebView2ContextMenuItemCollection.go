@@ -0,0 +1,77 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2ContextMenuItemCollectionVtbl struct {
+	_IUnknownVtbl
+	GetCount           ComProc
+	GetValueAtIndex    ComProc
+	RemoveValueAtIndex ComProc
+	InsertValueAtIndex ComProc
+}
+
+type ICoreWebView2ContextMenuItemCollection struct {
+	vtbl *_ICoreWebView2ContextMenuItemCollectionVtbl
+}
+
+func (i *ICoreWebView2ContextMenuItemCollection) Release() {
+	release(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2ContextMenuItemCollection) GetCount() (uint32, error) {
+	var err error
+	var count uint32
+	_, _, err = i.vtbl.GetCount.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&count)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	return count, nil
+}
+
+// GetValueAtIndex returns the item at index. The caller must Release the
+// result.
+func (i *ICoreWebView2ContextMenuItemCollection) GetValueAtIndex(index uint32) (*ICoreWebView2ContextMenuItem, error) {
+	var err error
+	var item *ICoreWebView2ContextMenuItem
+	_, _, err = i.vtbl.GetValueAtIndex.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(index),
+		uintptr(unsafe.Pointer(&item)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	return item, nil
+}
+
+func (i *ICoreWebView2ContextMenuItemCollection) RemoveValueAtIndex(index uint32) error {
+	var err error
+	_, _, err = i.vtbl.RemoveValueAtIndex.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(index),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2ContextMenuItemCollection) InsertValueAtIndex(index uint32, item *ICoreWebView2ContextMenuItem) error {
+	var err error
+	_, _, err = i.vtbl.InsertValueAtIndex.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(index),
+		uintptr(unsafe.Pointer(item)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
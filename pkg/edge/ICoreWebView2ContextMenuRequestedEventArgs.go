@@ -0,0 +1,69 @@
+package edge
+
+import (
+	"unsafe"
+
+	"github.com/project-vrcat/go-webview2/internal/w32"
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2ContextMenuRequestedEventArgsVtbl struct {
+	_IUnknownVtbl
+	GetMenuItems         ComProc
+	GetContextMenuTarget ComProc
+	GetLocation          ComProc
+	PutSelectedCommandId ComProc
+	GetSelectedCommandId ComProc
+	PutHandled           ComProc
+	GetHandled           ComProc
+	GetDeferral          ComProc
+}
+
+type ICoreWebView2ContextMenuRequestedEventArgs struct {
+	vtbl *_ICoreWebView2ContextMenuRequestedEventArgsVtbl
+}
+
+// GetMenuItems returns the items of the menu that is about to be shown.
+// Changes to the collection change the menu. The caller must Release the
+// result.
+func (i *ICoreWebView2ContextMenuRequestedEventArgs) GetMenuItems() (*ICoreWebView2ContextMenuItemCollection, error) {
+	var err error
+	var items *ICoreWebView2ContextMenuItemCollection
+	_, _, err = i.vtbl.GetMenuItems.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&items)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	return items, nil
+}
+
+// GetLocation returns where the menu was requested, relative to the top left
+// corner of the webview.
+func (i *ICoreWebView2ContextMenuRequestedEventArgs) GetLocation() (w32.Point, error) {
+	var err error
+	var location w32.Point
+	_, _, err = i.vtbl.GetLocation.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&location)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return w32.Point{}, err
+	}
+	return location, nil
+}
+
+// PutHandled set to true keeps WebView2 from showing the menu.
+func (i *ICoreWebView2ContextMenuRequestedEventArgs) PutHandled(handled bool) error {
+	var err error
+
+	_, _, err = i.vtbl.PutHandled.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(handled)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
@@ -93,3 +93,41 @@ func (i *iCoreWebView2Controller) PutIsVisible(isVisible bool) error {
 	}
 	return nil
 }
+
+func (i *iCoreWebView2Controller) MoveFocus(reason COREWEBVIEW2_MOVE_FOCUS_REASON) error {
+	var err error
+	_, _, err = i.vtbl.MoveFocus.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(reason),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *iCoreWebView2Controller) GetZoomFactor() (float64, error) {
+	var err error
+	var zoomFactor float64
+	_, _, err = i.vtbl.GetZoomFactor.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&zoomFactor)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	return zoomFactor, nil
+}
+
+func (i *iCoreWebView2Controller) AddZoomFactorChanged(eventHandler *ICoreWebView2ZoomFactorChangedEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddZoomFactorChanged.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
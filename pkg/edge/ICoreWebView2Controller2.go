@@ -0,0 +1,48 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var iidICoreWebView2Controller2 = windows.GUID{Data1: 0xC979903E, Data2: 0xD4CA, Data3: 0x4228, Data4: [8]byte{0x92, 0xEB, 0x47, 0xEE, 0x3F, 0xA9, 0x6E, 0xAB}}
+
+type iCoreWebView2Controller2Vtbl struct {
+	_ICoreWebView2ControllerVtbl
+	GetDefaultBackgroundColor ComProc
+	PutDefaultBackgroundColor ComProc
+}
+
+type iCoreWebView2Controller2 struct {
+	vtbl *iCoreWebView2Controller2Vtbl
+}
+
+// getICoreWebView2Controller2 returns the ICoreWebView2Controller2 interface
+// of the controller, or nil if the installed runtime does not implement it.
+// The caller must Release the result.
+func (i *iCoreWebView2Controller) getICoreWebView2Controller2() *iCoreWebView2Controller2 {
+	var result *iCoreWebView2Controller2
+	if !queryInterface(unsafe.Pointer(i), &iidICoreWebView2Controller2, unsafe.Pointer(&result)) {
+		return nil
+	}
+	return result
+}
+
+func (i *iCoreWebView2Controller2) Release() {
+	release(unsafe.Pointer(i))
+}
+
+func (i *iCoreWebView2Controller2) PutDefaultBackgroundColor(backgroundColor COREWEBVIEW2_COLOR) error {
+	var err error
+	// COREWEBVIEW2_COLOR is four bytes and is passed by value in a single
+	// register or stack slot.
+	_, _, err = i.vtbl.PutDefaultBackgroundColor.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(*(*uint32)(unsafe.Pointer(&backgroundColor))),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
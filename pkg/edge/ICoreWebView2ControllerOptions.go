@@ -0,0 +1,51 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2ControllerOptionsVtbl struct {
+	_IUnknownVtbl
+	GetProfileName            ComProc
+	PutProfileName            ComProc
+	GetIsInPrivateModeEnabled ComProc
+	PutIsInPrivateModeEnabled ComProc
+}
+
+type ICoreWebView2ControllerOptions struct {
+	vtbl *_ICoreWebView2ControllerOptionsVtbl
+}
+
+func (i *ICoreWebView2ControllerOptions) Release() {
+	release(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2ControllerOptions) PutProfileName(profileName string) error {
+	var err error
+	_profileName, err := windows.UTF16PtrFromString(profileName)
+	if err != nil {
+		return err
+	}
+	_, _, err = i.vtbl.PutProfileName.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_profileName)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2ControllerOptions) PutIsInPrivateModeEnabled(isInPrivateModeEnabled bool) error {
+	var err error
+	_, _, err = i.vtbl.PutIsInPrivateModeEnabled.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(isInPrivateModeEnabled)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2CookieVtbl struct {
+	_IUnknownVtbl
+	GetName       ComProc
+	GetValue      ComProc
+	PutValue      ComProc
+	GetDomain     ComProc
+	GetPath       ComProc
+	GetExpires    ComProc
+	PutExpires    ComProc
+	GetIsHttpOnly ComProc
+	PutIsHttpOnly ComProc
+	GetSameSite   ComProc
+	PutSameSite   ComProc
+	GetIsSecure   ComProc
+	PutIsSecure   ComProc
+	GetIsSession  ComProc
+}
+
+type ICoreWebView2Cookie struct {
+	vtbl *_ICoreWebView2CookieVtbl
+}
+
+func (i *ICoreWebView2Cookie) Release() {
+	release(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2Cookie) getString(proc ComProc) (string, error) {
+	var err error
+	var _value *uint16
+	_, _, err = proc.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	value := windows.UTF16PtrToString(_value)
+	windows.CoTaskMemFree(unsafe.Pointer(_value))
+	return value, nil
+}
+
+func (i *ICoreWebView2Cookie) getBool(proc ComProc) (bool, error) {
+	var err error
+	var value int32
+	_, _, err = proc.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	return value != 0, nil
+}
+
+func (i *ICoreWebView2Cookie) putBool(proc ComProc, value bool) error {
+	var err error
+	_, _, err = proc.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2Cookie) GetName() (string, error) {
+	return i.getString(i.vtbl.GetName)
+}
+
+func (i *ICoreWebView2Cookie) GetValue() (string, error) {
+	return i.getString(i.vtbl.GetValue)
+}
+
+func (i *ICoreWebView2Cookie) GetDomain() (string, error) {
+	return i.getString(i.vtbl.GetDomain)
+}
+
+func (i *ICoreWebView2Cookie) GetPath() (string, error) {
+	return i.getString(i.vtbl.GetPath)
+}
+
+// GetExpires returns the expiry of the cookie in seconds since the Unix
+// epoch, or -1 for a session cookie.
+func (i *ICoreWebView2Cookie) GetExpires() (float64, error) {
+	var err error
+	var expires float64
+	_, _, err = i.vtbl.GetExpires.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&expires)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	return expires, nil
+}
+
+func (i *ICoreWebView2Cookie) GetIsHttpOnly() (bool, error) {
+	return i.getBool(i.vtbl.GetIsHttpOnly)
+}
+
+func (i *ICoreWebView2Cookie) PutIsHttpOnly(isHttpOnly bool) error {
+	return i.putBool(i.vtbl.PutIsHttpOnly, isHttpOnly)
+}
+
+func (i *ICoreWebView2Cookie) GetSameSite() (COREWEBVIEW2_COOKIE_SAME_SITE_KIND, error) {
+	var err error
+	var sameSite COREWEBVIEW2_COOKIE_SAME_SITE_KIND
+	_, _, err = i.vtbl.GetSameSite.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&sameSite)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	return sameSite, nil
+}
+
+func (i *ICoreWebView2Cookie) PutSameSite(sameSite COREWEBVIEW2_COOKIE_SAME_SITE_KIND) error {
+	var err error
+	_, _, err = i.vtbl.PutSameSite.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(sameSite),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2Cookie) GetIsSecure() (bool, error) {
+	return i.getBool(i.vtbl.GetIsSecure)
+}
+
+func (i *ICoreWebView2Cookie) PutIsSecure(isSecure bool) error {
+	return i.putBool(i.vtbl.PutIsSecure, isSecure)
+}
+
+func (i *ICoreWebView2Cookie) GetIsSession() (bool, error) {
+	return i.getBool(i.vtbl.GetIsSession)
+}
@@ -0,0 +1,52 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2CookieListVtbl struct {
+	_IUnknownVtbl
+	GetCount        ComProc
+	GetValueAtIndex ComProc
+}
+
+type ICoreWebView2CookieList struct {
+	vtbl *_ICoreWebView2CookieListVtbl
+}
+
+func (i *ICoreWebView2CookieList) AddRef() {
+	addRef(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2CookieList) Release() {
+	release(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2CookieList) GetCount() (uint32, error) {
+	var err error
+	var count uint32
+	_, _, err = i.vtbl.GetCount.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&count)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (i *ICoreWebView2CookieList) GetValueAtIndex(index uint32) (*ICoreWebView2Cookie, error) {
+	var err error
+	var cookie *ICoreWebView2Cookie
+	_, _, err = i.vtbl.GetValueAtIndex.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(index),
+		uintptr(unsafe.Pointer(&cookie)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	return cookie, nil
+}
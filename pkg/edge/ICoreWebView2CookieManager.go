@@ -0,0 +1,132 @@
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2CookieManagerVtbl struct {
+	_IUnknownVtbl
+	CreateCookie                   ComProc
+	CopyCookie                     ComProc
+	GetCookies                     ComProc
+	AddOrUpdateCookie              ComProc
+	DeleteCookie                   ComProc
+	DeleteCookies                  ComProc
+	DeleteCookiesWithDomainAndPath ComProc
+	DeleteAllCookies               ComProc
+}
+
+type ICoreWebView2CookieManager struct {
+	vtbl *_ICoreWebView2CookieManagerVtbl
+}
+
+func (i *ICoreWebView2CookieManager) Release() {
+	release(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2CookieManager) CreateCookie(name, value, domain, path string) (*ICoreWebView2Cookie, error) {
+	_name, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return nil, err
+	}
+	_value, err := windows.UTF16PtrFromString(value)
+	if err != nil {
+		return nil, err
+	}
+	_domain, err := windows.UTF16PtrFromString(domain)
+	if err != nil {
+		return nil, err
+	}
+	_path, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return nil, err
+	}
+	var cookie *ICoreWebView2Cookie
+	hr, _, _ := i.vtbl.CreateCookie.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_name)),
+		uintptr(unsafe.Pointer(_value)),
+		uintptr(unsafe.Pointer(_domain)),
+		uintptr(unsafe.Pointer(_path)),
+		uintptr(unsafe.Pointer(&cookie)),
+	)
+	if int32(hr) < 0 {
+		return nil, syscall.Errno(hr)
+	}
+	return cookie, nil
+}
+
+// GetCookies calls completed on the UI thread with the cookies that would be
+// sent to uri, or all cookies when uri is empty.
+func (i *ICoreWebView2CookieManager) GetCookies(uri string, completed func(cookieList *ICoreWebView2CookieList, err error)) error {
+	_uri, err := windows.UTF16PtrFromString(uri)
+	if err != nil {
+		return err
+	}
+	handler := newICoreWebView2GetCookiesCompletedHandler(func(errorCode uintptr, cookieList *ICoreWebView2CookieList) {
+		if int32(errorCode) < 0 {
+			completed(nil, syscall.Errno(errorCode))
+			return
+		}
+		completed(cookieList, nil)
+	})
+	hr, _, _ := i.vtbl.GetCookies.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_uri)),
+		uintptr(unsafe.Pointer(handler)),
+	)
+	if int32(hr) < 0 {
+		unpin(unsafe.Pointer(handler))
+		return syscall.Errno(hr)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2CookieManager) AddOrUpdateCookie(cookie *ICoreWebView2Cookie) error {
+	hr, _, _ := i.vtbl.AddOrUpdateCookie.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(cookie)),
+	)
+	if int32(hr) < 0 {
+		return syscall.Errno(hr)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2CookieManager) DeleteCookiesWithDomainAndPath(name, domain, path string) error {
+	_name, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return err
+	}
+	_domain, err := windows.UTF16PtrFromString(domain)
+	if err != nil {
+		return err
+	}
+	_path, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return err
+	}
+	hr, _, _ := i.vtbl.DeleteCookiesWithDomainAndPath.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_name)),
+		uintptr(unsafe.Pointer(_domain)),
+		uintptr(unsafe.Pointer(_path)),
+	)
+	if int32(hr) < 0 {
+		return syscall.Errno(hr)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2CookieManager) DeleteAllCookies() error {
+	hr, _, _ := i.vtbl.DeleteAllCookies.Call(
+		uintptr(unsafe.Pointer(i)),
+	)
+	if int32(hr) < 0 {
+		return syscall.Errno(hr)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package edge
+
+import (
+	"unsafe"
+
+	"github.com/project-vrcat/go-webview2/internal/w32"
+	"golang.org/x/sys/windows"
+)
+
+var iidICoreWebView2CustomSchemeRegistration = windows.GUID{Data1: 0xD60AC92C, Data2: 0x37A6, Data3: 0x4B26, Data4: [8]byte{0xA3, 0x9E, 0x95, 0xCF, 0xE5, 0x90, 0x47, 0xBB}}
+
+// CustomSchemeRegistration registers a custom URI scheme with the browser
+// through EnvironmentOptions, so that requests to it reach
+// WebResourceRequested.
+type CustomSchemeRegistration struct {
+	// SchemeName is the scheme without the colon, such as "app"
+	SchemeName string
+
+	// TreatAsSecure gives pages of the scheme a secure context, like https
+	TreatAsSecure bool
+
+	// AllowedOrigins are other origins that may request resources of the
+	// scheme, or "*" for all of them
+	AllowedOrigins []string
+
+	// HasAuthorityComponent makes URIs of the scheme have a host, as in
+	// app://host/path
+	HasAuthorityComponent bool
+}
+
+// iCoreWebView2CustomSchemeRegistration is an
+// ICoreWebView2CustomSchemeRegistration implemented in Go.
+type iCoreWebView2CustomSchemeRegistration struct {
+	vtbl         *_ICoreWebView2CustomSchemeRegistrationVtbl
+	registration CustomSchemeRegistration
+}
+
+type _ICoreWebView2CustomSchemeRegistrationVtbl struct {
+	_IUnknownVtbl
+	GetSchemeName            ComProc
+	GetTreatAsSecure         ComProc
+	PutTreatAsSecure         ComProc
+	GetAllowedOrigins        ComProc
+	SetAllowedOrigins        ComProc
+	GetHasAuthorityComponent ComProc
+	PutHasAuthorityComponent ComProc
+}
+
+func newICoreWebView2CustomSchemeRegistration(registration CustomSchemeRegistration) *iCoreWebView2CustomSchemeRegistration {
+	return &iCoreWebView2CustomSchemeRegistration{
+		vtbl:         &iCoreWebView2CustomSchemeRegistrationFn,
+		registration: registration,
+	}
+}
+
+func _ICoreWebView2CustomSchemeRegistrationIUnknownQueryInterface(this *iCoreWebView2CustomSchemeRegistration, riid *windows.GUID, object *unsafe.Pointer) uintptr {
+	switch *riid {
+	case iidIUnknown, iidICoreWebView2CustomSchemeRegistration:
+		*object = unsafe.Pointer(this)
+		return _S_OK
+	}
+	*object = nil
+	return _E_NOINTERFACE
+}
+
+func _ICoreWebView2CustomSchemeRegistrationIUnknownAddRef(this *iCoreWebView2CustomSchemeRegistration) uintptr {
+	return 1
+}
+
+func _ICoreWebView2CustomSchemeRegistrationIUnknownRelease(this *iCoreWebView2CustomSchemeRegistration) uintptr {
+	return 1
+}
+
+func _ICoreWebView2CustomSchemeRegistrationGetSchemeName(this *iCoreWebView2CustomSchemeRegistration, value **uint16) uintptr {
+	return coTaskMemString(this.registration.SchemeName, value)
+}
+
+func _ICoreWebView2CustomSchemeRegistrationGetTreatAsSecure(this *iCoreWebView2CustomSchemeRegistration, value *int32) uintptr {
+	*value = int32(boolToInt(this.registration.TreatAsSecure))
+	return _S_OK
+}
+
+func _ICoreWebView2CustomSchemeRegistrationPutTreatAsSecure(this *iCoreWebView2CustomSchemeRegistration, value uintptr) uintptr {
+	this.registration.TreatAsSecure = value != 0
+	return _S_OK
+}
+
+func _ICoreWebView2CustomSchemeRegistrationGetAllowedOrigins(this *iCoreWebView2CustomSchemeRegistration, count *uint32, origins *uintptr) uintptr {
+	allowedOrigins := this.registration.AllowedOrigins
+	*count = 0
+	*origins = 0
+	if len(allowedOrigins) == 0 {
+		return _S_OK
+	}
+	p, _, _ := w32.Ole32CoTaskMemAlloc.Call(uintptr(len(allowedOrigins)) * unsafe.Sizeof(uintptr(0)))
+	if p == 0 {
+		return _E_OUTOFMEMORY
+	}
+	array := (*[1 << 20]*uint16)(unsafe.Pointer(p))[:len(allowedOrigins):len(allowedOrigins)]
+	for i, origin := range allowedOrigins {
+		if hr := coTaskMemString(origin, &array[i]); hr != _S_OK {
+			for _, s := range array[:i] {
+				windows.CoTaskMemFree(unsafe.Pointer(s))
+			}
+			windows.CoTaskMemFree(unsafe.Pointer(p))
+			return hr
+		}
+	}
+	*count = uint32(len(allowedOrigins))
+	*origins = p
+	return _S_OK
+}
+
+func _ICoreWebView2CustomSchemeRegistrationSetAllowedOrigins(this *iCoreWebView2CustomSchemeRegistration, count uintptr, origins **uint16) uintptr {
+	allowedOrigins := make([]string, count)
+	if count > 0 {
+		array := (*[1 << 20]*uint16)(unsafe.Pointer(origins))[:count:count]
+		for i, origin := range array {
+			allowedOrigins[i] = windows.UTF16PtrToString(origin)
+		}
+	}
+	this.registration.AllowedOrigins = allowedOrigins
+	return _S_OK
+}
+
+func _ICoreWebView2CustomSchemeRegistrationGetHasAuthorityComponent(this *iCoreWebView2CustomSchemeRegistration, value *int32) uintptr {
+	*value = int32(boolToInt(this.registration.HasAuthorityComponent))
+	return _S_OK
+}
+
+func _ICoreWebView2CustomSchemeRegistrationPutHasAuthorityComponent(this *iCoreWebView2CustomSchemeRegistration, value uintptr) uintptr {
+	this.registration.HasAuthorityComponent = value != 0
+	return _S_OK
+}
+
+var iCoreWebView2CustomSchemeRegistrationFn = _ICoreWebView2CustomSchemeRegistrationVtbl{
+	_IUnknownVtbl{
+		NewComProc(_ICoreWebView2CustomSchemeRegistrationIUnknownQueryInterface),
+		NewComProc(_ICoreWebView2CustomSchemeRegistrationIUnknownAddRef),
+		NewComProc(_ICoreWebView2CustomSchemeRegistrationIUnknownRelease),
+	},
+	NewComProc(_ICoreWebView2CustomSchemeRegistrationGetSchemeName),
+	NewComProc(_ICoreWebView2CustomSchemeRegistrationGetTreatAsSecure),
+	NewComProc(_ICoreWebView2CustomSchemeRegistrationPutTreatAsSecure),
+	NewComProc(_ICoreWebView2CustomSchemeRegistrationGetAllowedOrigins),
+	NewComProc(_ICoreWebView2CustomSchemeRegistrationSetAllowedOrigins),
+	NewComProc(_ICoreWebView2CustomSchemeRegistrationGetHasAuthorityComponent),
+	NewComProc(_ICoreWebView2CustomSchemeRegistrationPutHasAuthorityComponent),
+}
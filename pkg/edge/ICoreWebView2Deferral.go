@@ -0,0 +1,36 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2DeferralVtbl struct {
+	_IUnknownVtbl
+	Complete ComProc
+}
+
+// ICoreWebView2Deferral lets an event handler finish its work after it has
+// returned. WebView2 waits for Complete before acting on the event args.
+type ICoreWebView2Deferral struct {
+	vtbl *_ICoreWebView2DeferralVtbl
+}
+
+func (i *ICoreWebView2Deferral) Release() {
+	release(unsafe.Pointer(i))
+}
+
+// Complete signals that the deferred event has been handled and releases the
+// deferral.
+func (i *ICoreWebView2Deferral) Complete() error {
+	var err error
+	_, _, err = i.vtbl.Complete.Call(
+		uintptr(unsafe.Pointer(i)),
+	)
+	i.Release()
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
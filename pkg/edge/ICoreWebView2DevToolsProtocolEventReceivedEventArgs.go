@@ -0,0 +1,31 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2DevToolsProtocolEventReceivedEventArgsVtbl struct {
+	_IUnknownVtbl
+	GetParameterObjectAsJson ComProc
+}
+
+type ICoreWebView2DevToolsProtocolEventReceivedEventArgs struct {
+	vtbl *_ICoreWebView2DevToolsProtocolEventReceivedEventArgsVtbl
+}
+
+func (i *ICoreWebView2DevToolsProtocolEventReceivedEventArgs) GetParameterObjectAsJson() (string, error) {
+	var err error
+	var _json *uint16
+	_, _, err = i.vtbl.GetParameterObjectAsJson.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_json)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	json := windows.UTF16PtrToString(_json)
+	windows.CoTaskMemFree(unsafe.Pointer(_json))
+	return json, nil
+}
@@ -0,0 +1,53 @@
+package edge
+
+import (
+	"unsafe"
+)
+
+type _ICoreWebView2DevToolsProtocolEventReceivedEventHandlerVtbl struct {
+	_IUnknownVtbl
+	Invoke ComProc
+}
+
+// iCoreWebView2DevToolsProtocolEventReceivedEventHandler calls callback for
+// every event it receives. It stays pinned until it is removed from its
+// receiver.
+type iCoreWebView2DevToolsProtocolEventReceivedEventHandler struct {
+	vtbl     *_ICoreWebView2DevToolsProtocolEventReceivedEventHandlerVtbl
+	callback func(args *ICoreWebView2DevToolsProtocolEventReceivedEventArgs)
+}
+
+func _ICoreWebView2DevToolsProtocolEventReceivedEventHandlerIUnknownQueryInterface(this *iCoreWebView2DevToolsProtocolEventReceivedEventHandler, refiid, object uintptr) uintptr {
+	return 0
+}
+
+func _ICoreWebView2DevToolsProtocolEventReceivedEventHandlerIUnknownAddRef(this *iCoreWebView2DevToolsProtocolEventReceivedEventHandler) uintptr {
+	return 1
+}
+
+func _ICoreWebView2DevToolsProtocolEventReceivedEventHandlerIUnknownRelease(this *iCoreWebView2DevToolsProtocolEventReceivedEventHandler) uintptr {
+	return 1
+}
+
+func _ICoreWebView2DevToolsProtocolEventReceivedEventHandlerInvoke(this *iCoreWebView2DevToolsProtocolEventReceivedEventHandler, sender *ICoreWebView2, args *ICoreWebView2DevToolsProtocolEventReceivedEventArgs) uintptr {
+	this.callback(args)
+	return 0
+}
+
+var _ICoreWebView2DevToolsProtocolEventReceivedEventHandlerFn = _ICoreWebView2DevToolsProtocolEventReceivedEventHandlerVtbl{
+	_IUnknownVtbl{
+		NewComProc(_ICoreWebView2DevToolsProtocolEventReceivedEventHandlerIUnknownQueryInterface),
+		NewComProc(_ICoreWebView2DevToolsProtocolEventReceivedEventHandlerIUnknownAddRef),
+		NewComProc(_ICoreWebView2DevToolsProtocolEventReceivedEventHandlerIUnknownRelease),
+	},
+	NewComProc(_ICoreWebView2DevToolsProtocolEventReceivedEventHandlerInvoke),
+}
+
+func newICoreWebView2DevToolsProtocolEventReceivedEventHandler(callback func(args *ICoreWebView2DevToolsProtocolEventReceivedEventArgs)) *iCoreWebView2DevToolsProtocolEventReceivedEventHandler {
+	h := &iCoreWebView2DevToolsProtocolEventReceivedEventHandler{
+		vtbl:     &_ICoreWebView2DevToolsProtocolEventReceivedEventHandlerFn,
+		callback: callback,
+	}
+	pin(unsafe.Pointer(h))
+	return h
+}
@@ -0,0 +1,34 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2DevToolsProtocolEventReceiverVtbl struct {
+	_IUnknownVtbl
+	AddDevToolsProtocolEventReceived    ComProc
+	RemoveDevToolsProtocolEventReceived ComProc
+}
+
+type ICoreWebView2DevToolsProtocolEventReceiver struct {
+	vtbl *_ICoreWebView2DevToolsProtocolEventReceiverVtbl
+}
+
+func (i *ICoreWebView2DevToolsProtocolEventReceiver) Release() {
+	release(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2DevToolsProtocolEventReceiver) AddDevToolsProtocolEventReceived(eventHandler *iCoreWebView2DevToolsProtocolEventReceivedEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddDevToolsProtocolEventReceived.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
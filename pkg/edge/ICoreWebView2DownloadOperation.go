@@ -0,0 +1,133 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2DownloadOperationVtbl struct {
+	_IUnknownVtbl
+	AddBytesReceivedChanged       ComProc
+	RemoveBytesReceivedChanged    ComProc
+	AddEstimatedEndTimeChanged    ComProc
+	RemoveEstimatedEndTimeChanged ComProc
+	AddStateChanged               ComProc
+	RemoveStateChanged            ComProc
+	GetUri                        ComProc
+	GetContentDisposition         ComProc
+	GetMimeType                   ComProc
+	GetTotalBytesToReceive        ComProc
+	GetBytesReceived              ComProc
+	GetEstimatedEndTime           ComProc
+	GetResultFilePath             ComProc
+	GetState                      ComProc
+	GetInterruptReason            ComProc
+	Cancel                        ComProc
+	Pause                         ComProc
+	Resume                        ComProc
+	GetCanResume                  ComProc
+}
+
+type ICoreWebView2DownloadOperation struct {
+	vtbl *_ICoreWebView2DownloadOperationVtbl
+}
+
+func (i *ICoreWebView2DownloadOperation) AddRef() {
+	addRef(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2DownloadOperation) Release() {
+	release(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2DownloadOperation) AddStateChanged(eventHandler *ICoreWebView2StateChangedEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddStateChanged.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2DownloadOperation) getString(proc ComProc) (string, error) {
+	var err error
+	var _value *uint16
+	_, _, err = proc.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	value := windows.UTF16PtrToString(_value)
+	windows.CoTaskMemFree(unsafe.Pointer(_value))
+	return value, nil
+}
+
+func (i *ICoreWebView2DownloadOperation) call(proc ComProc) error {
+	var err error
+	_, _, err = proc.Call(
+		uintptr(unsafe.Pointer(i)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2DownloadOperation) GetUri() (string, error) {
+	return i.getString(i.vtbl.GetUri)
+}
+
+func (i *ICoreWebView2DownloadOperation) GetMimeType() (string, error) {
+	return i.getString(i.vtbl.GetMimeType)
+}
+
+func (i *ICoreWebView2DownloadOperation) GetResultFilePath() (string, error) {
+	return i.getString(i.vtbl.GetResultFilePath)
+}
+
+// GetTotalBytesToReceive returns the expected size of the download, or -1 if
+// the server did not report it.
+func (i *ICoreWebView2DownloadOperation) GetTotalBytesToReceive() (int64, error) {
+	var err error
+	var total int64
+	_, _, err = i.vtbl.GetTotalBytesToReceive.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&total)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	return total, nil
+}
+
+func (i *ICoreWebView2DownloadOperation) GetState() (COREWEBVIEW2_DOWNLOAD_STATE, error) {
+	var err error
+	var state COREWEBVIEW2_DOWNLOAD_STATE
+	_, _, err = i.vtbl.GetState.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&state)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	return state, nil
+}
+
+func (i *ICoreWebView2DownloadOperation) Cancel() error {
+	return i.call(i.vtbl.Cancel)
+}
+
+func (i *ICoreWebView2DownloadOperation) Pause() error {
+	return i.call(i.vtbl.Pause)
+}
+
+func (i *ICoreWebView2DownloadOperation) Resume() error {
+	return i.call(i.vtbl.Resume)
+}
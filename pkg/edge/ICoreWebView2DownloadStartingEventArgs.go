@@ -0,0 +1,98 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2DownloadStartingEventArgsVtbl struct {
+	_IUnknownVtbl
+	GetDownloadOperation ComProc
+	GetCancel            ComProc
+	PutCancel            ComProc
+	GetResultFilePath    ComProc
+	PutResultFilePath    ComProc
+	GetHandled           ComProc
+	PutHandled           ComProc
+	GetDeferral          ComProc
+}
+
+type ICoreWebView2DownloadStartingEventArgs struct {
+	vtbl *_ICoreWebView2DownloadStartingEventArgsVtbl
+}
+
+func (i *ICoreWebView2DownloadStartingEventArgs) AddRef() {
+	addRef(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2DownloadStartingEventArgs) Release() {
+	release(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2DownloadStartingEventArgs) GetDownloadOperation() (*ICoreWebView2DownloadOperation, error) {
+	var err error
+	var operation *ICoreWebView2DownloadOperation
+	_, _, err = i.vtbl.GetDownloadOperation.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&operation)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	return operation, nil
+}
+
+func (i *ICoreWebView2DownloadStartingEventArgs) PutCancel(cancel bool) error {
+	var err error
+	_, _, err = i.vtbl.PutCancel.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(cancel)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2DownloadStartingEventArgs) GetResultFilePath() (string, error) {
+	var err error
+	var _path *uint16
+	_, _, err = i.vtbl.GetResultFilePath.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_path)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	path := windows.UTF16PtrToString(_path)
+	windows.CoTaskMemFree(unsafe.Pointer(_path))
+	return path, nil
+}
+
+func (i *ICoreWebView2DownloadStartingEventArgs) PutResultFilePath(path string) error {
+	_path, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return err
+	}
+	_, _, err = i.vtbl.PutResultFilePath.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_path)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2DownloadStartingEventArgs) PutHandled(handled bool) error {
+	var err error
+	_, _, err = i.vtbl.PutHandled.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(handled)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
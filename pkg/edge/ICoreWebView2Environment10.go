@@ -0,0 +1,62 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var iidICoreWebView2Environment10 = windows.GUID{Data1: 0xEE0EB9DF, Data2: 0x6F12, Data3: 0x46CE, Data4: [8]byte{0xB5, 0x3F, 0x3F, 0x47, 0xB9, 0xC9, 0x28, 0xE0}}
+
+type iCoreWebView2Environment10Vtbl struct {
+	iCoreWebView2Environment9Vtbl
+	CreateCoreWebView2ControllerOptions                ComProc
+	CreateCoreWebView2ControllerWithOptions            ComProc
+	CreateCoreWebView2CompositionControllerWithOptions ComProc
+}
+
+type ICoreWebView2Environment10 struct {
+	vtbl *iCoreWebView2Environment10Vtbl
+}
+
+// GetICoreWebView2Environment10 returns the ICoreWebView2Environment10 interface of the environment, or
+// nil if the installed runtime does not implement it. The caller must Release
+// the result.
+func (e *ICoreWebView2Environment) GetICoreWebView2Environment10() *ICoreWebView2Environment10 {
+	var result *ICoreWebView2Environment10
+	if !queryInterface(unsafe.Pointer(e), &iidICoreWebView2Environment10, unsafe.Pointer(&result)) {
+		return nil
+	}
+	return result
+}
+
+func (e *ICoreWebView2Environment10) Release() {
+	release(unsafe.Pointer(e))
+}
+
+func (e *ICoreWebView2Environment10) CreateCoreWebView2ControllerOptions() (*ICoreWebView2ControllerOptions, error) {
+	var err error
+	var options *ICoreWebView2ControllerOptions
+	_, _, err = e.vtbl.CreateCoreWebView2ControllerOptions.Call(
+		uintptr(unsafe.Pointer(e)),
+		uintptr(unsafe.Pointer(&options)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	return options, nil
+}
+
+func (e *ICoreWebView2Environment10) CreateCoreWebView2ControllerWithOptions(parentWindow uintptr, options *ICoreWebView2ControllerOptions, handler *iCoreWebView2CreateCoreWebView2ControllerCompletedHandler) error {
+	var err error
+	_, _, err = e.vtbl.CreateCoreWebView2ControllerWithOptions.Call(
+		uintptr(unsafe.Pointer(e)),
+		parentWindow,
+		uintptr(unsafe.Pointer(options)),
+		uintptr(unsafe.Pointer(handler)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
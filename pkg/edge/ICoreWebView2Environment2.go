@@ -0,0 +1,77 @@
+package edge
+
+import (
+	"unsafe"
+
+	"github.com/project-vrcat/go-webview2/internal/w32"
+	"golang.org/x/sys/windows"
+)
+
+var iidICoreWebView2Environment2 = windows.GUID{Data1: 0x41F3632B, Data2: 0x5EF4, Data3: 0x404F, Data4: [8]byte{0xAD, 0x82, 0x2D, 0x60, 0x6C, 0x5A, 0x9A, 0x21}}
+
+type iCoreWebView2Environment2Vtbl struct {
+	iCoreWebView2EnvironmentVtbl
+	CreateWebResourceRequest ComProc
+}
+
+type ICoreWebView2Environment2 struct {
+	vtbl *iCoreWebView2Environment2Vtbl
+}
+
+// GetICoreWebView2Environment2 returns the ICoreWebView2Environment2
+// interface of the environment, or nil if the installed runtime does not
+// implement it. The caller must Release the result.
+func (e *ICoreWebView2Environment) GetICoreWebView2Environment2() *ICoreWebView2Environment2 {
+	var result *ICoreWebView2Environment2
+	if !queryInterface(unsafe.Pointer(e), &iidICoreWebView2Environment2, unsafe.Pointer(&result)) {
+		return nil
+	}
+	return result
+}
+
+func (e *ICoreWebView2Environment2) Release() {
+	release(unsafe.Pointer(e))
+}
+
+// CreateWebResourceRequest creates a request for use with
+// NavigateWithWebResourceRequest. headers holds "Name: value" lines separated
+// by CRLF; content may be nil.
+func (e *ICoreWebView2Environment2) CreateWebResourceRequest(uri, method string, content []byte, headers string) (*ICoreWebView2WebResourceRequest, error) {
+	var err error
+
+	_uri, err := windows.UTF16PtrFromString(uri)
+	if err != nil {
+		return nil, err
+	}
+	_method, err := windows.UTF16PtrFromString(method)
+	if err != nil {
+		return nil, err
+	}
+	_headers, err := windows.UTF16PtrFromString(headers)
+	if err != nil {
+		return nil, err
+	}
+
+	var stream uintptr
+	if len(content) > 0 {
+		stream, err = w32.SHCreateMemStream(content)
+		if err != nil {
+			return nil, err
+		}
+		defer release(unsafe.Pointer(stream))
+	}
+
+	var request *ICoreWebView2WebResourceRequest
+	_, _, err = e.vtbl.CreateWebResourceRequest.Call(
+		uintptr(unsafe.Pointer(e)),
+		uintptr(unsafe.Pointer(_uri)),
+		uintptr(unsafe.Pointer(_method)),
+		stream,
+		uintptr(unsafe.Pointer(_headers)),
+		uintptr(unsafe.Pointer(&request)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	return request, nil
+}
@@ -0,0 +1,34 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var iidICoreWebView2Environment3 = windows.GUID{Data1: 0x80A22AE3, Data2: 0xBE7C, Data3: 0x4CE2, Data4: [8]byte{0xAF, 0xE1, 0x5A, 0x50, 0x05, 0x6C, 0xDE, 0xEB}}
+
+type iCoreWebView2Environment3Vtbl struct {
+	iCoreWebView2Environment2Vtbl
+	CreateCoreWebView2CompositionController ComProc
+	CreateCoreWebView2PointerInfo           ComProc
+}
+
+type ICoreWebView2Environment3 struct {
+	vtbl *iCoreWebView2Environment3Vtbl
+}
+
+// GetICoreWebView2Environment3 returns the ICoreWebView2Environment3 interface of the environment, or
+// nil if the installed runtime does not implement it. The caller must Release
+// the result.
+func (e *ICoreWebView2Environment) GetICoreWebView2Environment3() *ICoreWebView2Environment3 {
+	var result *ICoreWebView2Environment3
+	if !queryInterface(unsafe.Pointer(e), &iidICoreWebView2Environment3, unsafe.Pointer(&result)) {
+		return nil
+	}
+	return result
+}
+
+func (e *ICoreWebView2Environment3) Release() {
+	release(unsafe.Pointer(e))
+}
@@ -0,0 +1,33 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var iidICoreWebView2Environment4 = windows.GUID{Data1: 0x20944379, Data2: 0x6DCF, Data3: 0x41D6, Data4: [8]byte{0xA0, 0xA0, 0xAB, 0xC0, 0xFC, 0x50, 0xDE, 0x0D}}
+
+type iCoreWebView2Environment4Vtbl struct {
+	iCoreWebView2Environment3Vtbl
+	GetAutomationProviderForWindow ComProc
+}
+
+type ICoreWebView2Environment4 struct {
+	vtbl *iCoreWebView2Environment4Vtbl
+}
+
+// GetICoreWebView2Environment4 returns the ICoreWebView2Environment4 interface of the environment, or
+// nil if the installed runtime does not implement it. The caller must Release
+// the result.
+func (e *ICoreWebView2Environment) GetICoreWebView2Environment4() *ICoreWebView2Environment4 {
+	var result *ICoreWebView2Environment4
+	if !queryInterface(unsafe.Pointer(e), &iidICoreWebView2Environment4, unsafe.Pointer(&result)) {
+		return nil
+	}
+	return result
+}
+
+func (e *ICoreWebView2Environment4) Release() {
+	release(unsafe.Pointer(e))
+}
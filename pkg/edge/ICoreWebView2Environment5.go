@@ -0,0 +1,34 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var iidICoreWebView2Environment5 = windows.GUID{Data1: 0x319E423D, Data2: 0xE0D7, Data3: 0x4B8D, Data4: [8]byte{0x92, 0x54, 0xAE, 0x94, 0x75, 0xDE, 0x9B, 0x17}}
+
+type iCoreWebView2Environment5Vtbl struct {
+	iCoreWebView2Environment4Vtbl
+	AddBrowserProcessExited    ComProc
+	RemoveBrowserProcessExited ComProc
+}
+
+type ICoreWebView2Environment5 struct {
+	vtbl *iCoreWebView2Environment5Vtbl
+}
+
+// GetICoreWebView2Environment5 returns the ICoreWebView2Environment5 interface of the environment, or
+// nil if the installed runtime does not implement it. The caller must Release
+// the result.
+func (e *ICoreWebView2Environment) GetICoreWebView2Environment5() *ICoreWebView2Environment5 {
+	var result *ICoreWebView2Environment5
+	if !queryInterface(unsafe.Pointer(e), &iidICoreWebView2Environment5, unsafe.Pointer(&result)) {
+		return nil
+	}
+	return result
+}
+
+func (e *ICoreWebView2Environment5) Release() {
+	release(unsafe.Pointer(e))
+}
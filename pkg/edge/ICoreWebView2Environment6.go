@@ -0,0 +1,46 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var iidICoreWebView2Environment6 = windows.GUID{Data1: 0xE59EE362, Data2: 0xACBD, Data3: 0x4857, Data4: [8]byte{0x9A, 0x8E, 0xD3, 0x64, 0x4D, 0x94, 0x59, 0xA9}}
+
+type iCoreWebView2Environment6Vtbl struct {
+	iCoreWebView2Environment5Vtbl
+	CreatePrintSettings ComProc
+}
+
+type ICoreWebView2Environment6 struct {
+	vtbl *iCoreWebView2Environment6Vtbl
+}
+
+// GetICoreWebView2Environment6 returns the ICoreWebView2Environment6 interface of the environment, or
+// nil if the installed runtime does not implement it. The caller must Release
+// the result.
+func (e *ICoreWebView2Environment) GetICoreWebView2Environment6() *ICoreWebView2Environment6 {
+	var result *ICoreWebView2Environment6
+	if !queryInterface(unsafe.Pointer(e), &iidICoreWebView2Environment6, unsafe.Pointer(&result)) {
+		return nil
+	}
+	return result
+}
+
+func (e *ICoreWebView2Environment6) Release() {
+	release(unsafe.Pointer(e))
+}
+
+func (e *ICoreWebView2Environment6) CreatePrintSettings() (*ICoreWebView2PrintSettings, error) {
+	var err error
+	var printSettings *ICoreWebView2PrintSettings
+	_, _, err = e.vtbl.CreatePrintSettings.Call(
+		uintptr(unsafe.Pointer(e)),
+		uintptr(unsafe.Pointer(&printSettings)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	return printSettings, nil
+}
@@ -0,0 +1,33 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var iidICoreWebView2Environment7 = windows.GUID{Data1: 0x43C22296, Data2: 0x3BBD, Data3: 0x43A4, Data4: [8]byte{0x9C, 0x00, 0x5C, 0x0D, 0xF6, 0xDD, 0x29, 0xA2}}
+
+type iCoreWebView2Environment7Vtbl struct {
+	iCoreWebView2Environment6Vtbl
+	GetUserDataFolder ComProc
+}
+
+type ICoreWebView2Environment7 struct {
+	vtbl *iCoreWebView2Environment7Vtbl
+}
+
+// GetICoreWebView2Environment7 returns the ICoreWebView2Environment7 interface of the environment, or
+// nil if the installed runtime does not implement it. The caller must Release
+// the result.
+func (e *ICoreWebView2Environment) GetICoreWebView2Environment7() *ICoreWebView2Environment7 {
+	var result *ICoreWebView2Environment7
+	if !queryInterface(unsafe.Pointer(e), &iidICoreWebView2Environment7, unsafe.Pointer(&result)) {
+		return nil
+	}
+	return result
+}
+
+func (e *ICoreWebView2Environment7) Release() {
+	release(unsafe.Pointer(e))
+}
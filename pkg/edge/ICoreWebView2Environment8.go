@@ -0,0 +1,35 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var iidICoreWebView2Environment8 = windows.GUID{Data1: 0xD6EB91DD, Data2: 0xC3D2, Data3: 0x45E5, Data4: [8]byte{0xBD, 0x29, 0x6D, 0xC2, 0xBC, 0x4D, 0xE9, 0xCF}}
+
+type iCoreWebView2Environment8Vtbl struct {
+	iCoreWebView2Environment7Vtbl
+	AddProcessInfosChanged    ComProc
+	RemoveProcessInfosChanged ComProc
+	GetProcessInfos           ComProc
+}
+
+type ICoreWebView2Environment8 struct {
+	vtbl *iCoreWebView2Environment8Vtbl
+}
+
+// GetICoreWebView2Environment8 returns the ICoreWebView2Environment8 interface of the environment, or
+// nil if the installed runtime does not implement it. The caller must Release
+// the result.
+func (e *ICoreWebView2Environment) GetICoreWebView2Environment8() *ICoreWebView2Environment8 {
+	var result *ICoreWebView2Environment8
+	if !queryInterface(unsafe.Pointer(e), &iidICoreWebView2Environment8, unsafe.Pointer(&result)) {
+		return nil
+	}
+	return result
+}
+
+func (e *ICoreWebView2Environment8) Release() {
+	release(unsafe.Pointer(e))
+}
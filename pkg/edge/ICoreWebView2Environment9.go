@@ -0,0 +1,55 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var iidICoreWebView2Environment9 = windows.GUID{Data1: 0xF06F41BF, Data2: 0x4B5A, Data3: 0x49D8, Data4: [8]byte{0xB9, 0xF6, 0xFA, 0x16, 0xCD, 0x29, 0xF2, 0x74}}
+
+type iCoreWebView2Environment9Vtbl struct {
+	iCoreWebView2Environment8Vtbl
+	CreateContextMenuItem ComProc
+}
+
+type ICoreWebView2Environment9 struct {
+	vtbl *iCoreWebView2Environment9Vtbl
+}
+
+// GetICoreWebView2Environment9 returns the ICoreWebView2Environment9 interface of the environment, or
+// nil if the installed runtime does not implement it. The caller must Release
+// the result.
+func (e *ICoreWebView2Environment) GetICoreWebView2Environment9() *ICoreWebView2Environment9 {
+	var result *ICoreWebView2Environment9
+	if !queryInterface(unsafe.Pointer(e), &iidICoreWebView2Environment9, unsafe.Pointer(&result)) {
+		return nil
+	}
+	return result
+}
+
+func (e *ICoreWebView2Environment9) Release() {
+	release(unsafe.Pointer(e))
+}
+
+// CreateContextMenuItem creates a custom item for a context menu. Selecting it
+// raises CustomItemSelected on the item.
+func (e *ICoreWebView2Environment9) CreateContextMenuItem(label string, kind COREWEBVIEW2_CONTEXT_MENU_ITEM_KIND) (*ICoreWebView2ContextMenuItem, error) {
+	var err error
+	_label, err := windows.UTF16PtrFromString(label)
+	if err != nil {
+		return nil, err
+	}
+	var item *ICoreWebView2ContextMenuItem
+	_, _, err = e.vtbl.CreateContextMenuItem.Call(
+		uintptr(unsafe.Pointer(e)),
+		uintptr(unsafe.Pointer(_label)),
+		0,
+		uintptr(kind),
+		uintptr(unsafe.Pointer(&item)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	return item, nil
+}
@@ -0,0 +1,280 @@
+package edge
+
+import (
+	"strings"
+	"unsafe"
+
+	"github.com/project-vrcat/go-webview2/internal/w32"
+	"golang.org/x/sys/windows"
+)
+
+var (
+	iidIUnknown                         = windows.GUID{Data1: 0x00000000, Data2: 0x0000, Data3: 0x0000, Data4: [8]byte{0xC0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}}
+	iidICoreWebView2EnvironmentOptions  = windows.GUID{Data1: 0x2FDE08A8, Data2: 0x1E9A, Data3: 0x4766, Data4: [8]byte{0x8C, 0x05, 0x95, 0xA9, 0xCE, 0xB9, 0xD1, 0xC5}}
+	iidICoreWebView2EnvironmentOptions4 = windows.GUID{Data1: 0xAC52D13F, Data2: 0x0D38, Data3: 0x475A, Data4: [8]byte{0x9D, 0xCA, 0x87, 0x65, 0x80, 0xD6, 0x79, 0x3E}}
+	iidICoreWebView2EnvironmentOptions5 = windows.GUID{Data1: 0x0AE35D64, Data2: 0xC47F, Data3: 0x4464, Data4: [8]byte{0x81, 0x4E, 0x25, 0x9C, 0x34, 0x5D, 0x15, 0x01}}
+)
+
+const (
+	_S_OK          = 0
+	_E_NOTIMPL     = 0x80004001
+	_E_NOINTERFACE = 0x80004002
+	_E_OUTOFMEMORY = 0x8007000E
+)
+
+// defaultTargetCompatibleBrowserVersion is the oldest runtime the loader
+// accepts, the first release of WebView2.
+const defaultTargetCompatibleBrowserVersion = "86.0.616.0"
+
+// iCoreWebView2EnvironmentOptions is an ICoreWebView2EnvironmentOptions
+// implemented in Go and passed to CreateCoreWebView2EnvironmentWithOptions.
+// It also implements ICoreWebView2EnvironmentOptions4 and 5 through options4
+// and options5.
+type iCoreWebView2EnvironmentOptions struct {
+	vtbl     *_ICoreWebView2EnvironmentOptionsVtbl
+	options4 iCoreWebView2EnvironmentOptions4
+	options5 iCoreWebView2EnvironmentOptions5
+
+	additionalBrowserArguments             string
+	language                               string
+	targetCompatibleBrowserVersion         string
+	allowSingleSignOnUsingOSPrimaryAccount bool
+	enableTrackingPrevention               bool
+	customSchemeRegistrations              []*iCoreWebView2CustomSchemeRegistration
+}
+
+type iCoreWebView2EnvironmentOptions4 struct {
+	vtbl   *_ICoreWebView2EnvironmentOptions4Vtbl
+	parent *iCoreWebView2EnvironmentOptions
+}
+
+type iCoreWebView2EnvironmentOptions5 struct {
+	vtbl   *_ICoreWebView2EnvironmentOptions5Vtbl
+	parent *iCoreWebView2EnvironmentOptions
+}
+
+type _ICoreWebView2EnvironmentOptionsVtbl struct {
+	_IUnknownVtbl
+	GetAdditionalBrowserArguments             ComProc
+	PutAdditionalBrowserArguments             ComProc
+	GetLanguage                               ComProc
+	PutLanguage                               ComProc
+	GetTargetCompatibleBrowserVersion         ComProc
+	PutTargetCompatibleBrowserVersion         ComProc
+	GetAllowSingleSignOnUsingOSPrimaryAccount ComProc
+	PutAllowSingleSignOnUsingOSPrimaryAccount ComProc
+}
+
+type _ICoreWebView2EnvironmentOptions4Vtbl struct {
+	_IUnknownVtbl
+	GetCustomSchemeRegistrations ComProc
+	SetCustomSchemeRegistrations ComProc
+}
+
+type _ICoreWebView2EnvironmentOptions5Vtbl struct {
+	_IUnknownVtbl
+	GetEnableTrackingPrevention ComProc
+	PutEnableTrackingPrevention ComProc
+}
+
+func newICoreWebView2EnvironmentOptions(opts EnvironmentOptions) *iCoreWebView2EnvironmentOptions {
+	args := opts.AdditionalBrowserArguments
+	args = append(args[:len(args):len(args)], opts.Proxy.proxyArguments()...)
+	if opts.DisableHardwareAcceleration {
+		args = append(args, "--disable-gpu", "--disable-gpu-compositing", "--software-rendering-fallback")
+	}
+	options := &iCoreWebView2EnvironmentOptions{
+		vtbl:                           &iCoreWebView2EnvironmentOptionsFn,
+		additionalBrowserArguments:     strings.Join(args, " "),
+		language:                       opts.Language,
+		targetCompatibleBrowserVersion: defaultTargetCompatibleBrowserVersion,
+		enableTrackingPrevention:       true,
+	}
+	if opts.EnableTrackingPrevention != nil {
+		options.enableTrackingPrevention = *opts.EnableTrackingPrevention
+	}
+	for _, registration := range opts.CustomSchemeRegistrations {
+		options.customSchemeRegistrations = append(options.customSchemeRegistrations, newICoreWebView2CustomSchemeRegistration(registration))
+	}
+	options.options4 = iCoreWebView2EnvironmentOptions4{
+		vtbl:   &iCoreWebView2EnvironmentOptions4Fn,
+		parent: options,
+	}
+	options.options5 = iCoreWebView2EnvironmentOptions5{
+		vtbl:   &iCoreWebView2EnvironmentOptions5Fn,
+		parent: options,
+	}
+	return options
+}
+
+func (i *iCoreWebView2EnvironmentOptions) queryInterface(riid *windows.GUID, object *unsafe.Pointer) uintptr {
+	switch *riid {
+	case iidIUnknown, iidICoreWebView2EnvironmentOptions:
+		*object = unsafe.Pointer(i)
+	case iidICoreWebView2EnvironmentOptions4:
+		*object = unsafe.Pointer(&i.options4)
+	case iidICoreWebView2EnvironmentOptions5:
+		*object = unsafe.Pointer(&i.options5)
+	default:
+		*object = nil
+		return _E_NOINTERFACE
+	}
+	return _S_OK
+}
+
+// coTaskMemString copies s to memory allocated with CoTaskMemAlloc, which the
+// caller of a COM getter frees.
+func coTaskMemString(s string, out **uint16) uintptr {
+	u, err := windows.UTF16FromString(s)
+	if err != nil {
+		u = []uint16{0}
+	}
+	p, _, _ := w32.Ole32CoTaskMemAlloc.Call(uintptr(len(u) * 2))
+	if p == 0 {
+		return _E_OUTOFMEMORY
+	}
+	copy((*[1 << 29]uint16)(unsafe.Pointer(p))[:len(u):len(u)], u)
+	*out = (*uint16)(unsafe.Pointer(p))
+	return _S_OK
+}
+
+func _ICoreWebView2EnvironmentOptionsIUnknownQueryInterface(this *iCoreWebView2EnvironmentOptions, riid *windows.GUID, object *unsafe.Pointer) uintptr {
+	return this.queryInterface(riid, object)
+}
+
+func _ICoreWebView2EnvironmentOptionsIUnknownAddRef(this *iCoreWebView2EnvironmentOptions) uintptr {
+	return 1
+}
+
+func _ICoreWebView2EnvironmentOptionsIUnknownRelease(this *iCoreWebView2EnvironmentOptions) uintptr {
+	return 1
+}
+
+func _ICoreWebView2EnvironmentOptionsGetAdditionalBrowserArguments(this *iCoreWebView2EnvironmentOptions, value **uint16) uintptr {
+	return coTaskMemString(this.additionalBrowserArguments, value)
+}
+
+func _ICoreWebView2EnvironmentOptionsPutAdditionalBrowserArguments(this *iCoreWebView2EnvironmentOptions, value *uint16) uintptr {
+	this.additionalBrowserArguments = windows.UTF16PtrToString(value)
+	return _S_OK
+}
+
+func _ICoreWebView2EnvironmentOptionsGetLanguage(this *iCoreWebView2EnvironmentOptions, value **uint16) uintptr {
+	return coTaskMemString(this.language, value)
+}
+
+func _ICoreWebView2EnvironmentOptionsPutLanguage(this *iCoreWebView2EnvironmentOptions, value *uint16) uintptr {
+	this.language = windows.UTF16PtrToString(value)
+	return _S_OK
+}
+
+func _ICoreWebView2EnvironmentOptionsGetTargetCompatibleBrowserVersion(this *iCoreWebView2EnvironmentOptions, value **uint16) uintptr {
+	return coTaskMemString(this.targetCompatibleBrowserVersion, value)
+}
+
+func _ICoreWebView2EnvironmentOptionsPutTargetCompatibleBrowserVersion(this *iCoreWebView2EnvironmentOptions, value *uint16) uintptr {
+	this.targetCompatibleBrowserVersion = windows.UTF16PtrToString(value)
+	return _S_OK
+}
+
+func _ICoreWebView2EnvironmentOptionsGetAllowSingleSignOnUsingOSPrimaryAccount(this *iCoreWebView2EnvironmentOptions, value *int32) uintptr {
+	*value = int32(boolToInt(this.allowSingleSignOnUsingOSPrimaryAccount))
+	return _S_OK
+}
+
+func _ICoreWebView2EnvironmentOptionsPutAllowSingleSignOnUsingOSPrimaryAccount(this *iCoreWebView2EnvironmentOptions, value uintptr) uintptr {
+	this.allowSingleSignOnUsingOSPrimaryAccount = value != 0
+	return _S_OK
+}
+
+var iCoreWebView2EnvironmentOptionsFn = _ICoreWebView2EnvironmentOptionsVtbl{
+	_IUnknownVtbl{
+		NewComProc(_ICoreWebView2EnvironmentOptionsIUnknownQueryInterface),
+		NewComProc(_ICoreWebView2EnvironmentOptionsIUnknownAddRef),
+		NewComProc(_ICoreWebView2EnvironmentOptionsIUnknownRelease),
+	},
+	NewComProc(_ICoreWebView2EnvironmentOptionsGetAdditionalBrowserArguments),
+	NewComProc(_ICoreWebView2EnvironmentOptionsPutAdditionalBrowserArguments),
+	NewComProc(_ICoreWebView2EnvironmentOptionsGetLanguage),
+	NewComProc(_ICoreWebView2EnvironmentOptionsPutLanguage),
+	NewComProc(_ICoreWebView2EnvironmentOptionsGetTargetCompatibleBrowserVersion),
+	NewComProc(_ICoreWebView2EnvironmentOptionsPutTargetCompatibleBrowserVersion),
+	NewComProc(_ICoreWebView2EnvironmentOptionsGetAllowSingleSignOnUsingOSPrimaryAccount),
+	NewComProc(_ICoreWebView2EnvironmentOptionsPutAllowSingleSignOnUsingOSPrimaryAccount),
+}
+
+func _ICoreWebView2EnvironmentOptions4IUnknownQueryInterface(this *iCoreWebView2EnvironmentOptions4, riid *windows.GUID, object *unsafe.Pointer) uintptr {
+	return this.parent.queryInterface(riid, object)
+}
+
+func _ICoreWebView2EnvironmentOptions4IUnknownAddRef(this *iCoreWebView2EnvironmentOptions4) uintptr {
+	return 1
+}
+
+func _ICoreWebView2EnvironmentOptions4IUnknownRelease(this *iCoreWebView2EnvironmentOptions4) uintptr {
+	return 1
+}
+
+func _ICoreWebView2EnvironmentOptions4GetCustomSchemeRegistrations(this *iCoreWebView2EnvironmentOptions4, count *uint32, schemeRegistrations *uintptr) uintptr {
+	registrations := this.parent.customSchemeRegistrations
+	*count = 0
+	*schemeRegistrations = 0
+	if len(registrations) == 0 {
+		return _S_OK
+	}
+	p, _, _ := w32.Ole32CoTaskMemAlloc.Call(uintptr(len(registrations)) * unsafe.Sizeof(uintptr(0)))
+	if p == 0 {
+		return _E_OUTOFMEMORY
+	}
+	array := (*[1 << 20]*iCoreWebView2CustomSchemeRegistration)(unsafe.Pointer(p))[:len(registrations):len(registrations)]
+	copy(array, registrations)
+	*count = uint32(len(registrations))
+	*schemeRegistrations = p
+	return _S_OK
+}
+
+func _ICoreWebView2EnvironmentOptions4SetCustomSchemeRegistrations(this *iCoreWebView2EnvironmentOptions4, count uintptr, schemeRegistrations uintptr) uintptr {
+	return _E_NOTIMPL
+}
+
+var iCoreWebView2EnvironmentOptions4Fn = _ICoreWebView2EnvironmentOptions4Vtbl{
+	_IUnknownVtbl{
+		NewComProc(_ICoreWebView2EnvironmentOptions4IUnknownQueryInterface),
+		NewComProc(_ICoreWebView2EnvironmentOptions4IUnknownAddRef),
+		NewComProc(_ICoreWebView2EnvironmentOptions4IUnknownRelease),
+	},
+	NewComProc(_ICoreWebView2EnvironmentOptions4GetCustomSchemeRegistrations),
+	NewComProc(_ICoreWebView2EnvironmentOptions4SetCustomSchemeRegistrations),
+}
+
+func _ICoreWebView2EnvironmentOptions5IUnknownQueryInterface(this *iCoreWebView2EnvironmentOptions5, riid *windows.GUID, object *unsafe.Pointer) uintptr {
+	return this.parent.queryInterface(riid, object)
+}
+
+func _ICoreWebView2EnvironmentOptions5IUnknownAddRef(this *iCoreWebView2EnvironmentOptions5) uintptr {
+	return 1
+}
+
+func _ICoreWebView2EnvironmentOptions5IUnknownRelease(this *iCoreWebView2EnvironmentOptions5) uintptr {
+	return 1
+}
+
+func _ICoreWebView2EnvironmentOptions5GetEnableTrackingPrevention(this *iCoreWebView2EnvironmentOptions5, value *int32) uintptr {
+	*value = int32(boolToInt(this.parent.enableTrackingPrevention))
+	return _S_OK
+}
+
+func _ICoreWebView2EnvironmentOptions5PutEnableTrackingPrevention(this *iCoreWebView2EnvironmentOptions5, value uintptr) uintptr {
+	this.parent.enableTrackingPrevention = value != 0
+	return _S_OK
+}
+
+var iCoreWebView2EnvironmentOptions5Fn = _ICoreWebView2EnvironmentOptions5Vtbl{
+	_IUnknownVtbl{
+		NewComProc(_ICoreWebView2EnvironmentOptions5IUnknownQueryInterface),
+		NewComProc(_ICoreWebView2EnvironmentOptions5IUnknownAddRef),
+		NewComProc(_ICoreWebView2EnvironmentOptions5IUnknownRelease),
+	},
+	NewComProc(_ICoreWebView2EnvironmentOptions5GetEnableTrackingPrevention),
+	NewComProc(_ICoreWebView2EnvironmentOptions5PutEnableTrackingPrevention),
+}
@@ -0,0 +1,51 @@
+package edge
+
+import (
+	"unsafe"
+)
+
+type _ICoreWebView2GetCookiesCompletedHandlerVtbl struct {
+	_IUnknownVtbl
+	Invoke ComProc
+}
+
+type iCoreWebView2GetCookiesCompletedHandler struct {
+	vtbl     *_ICoreWebView2GetCookiesCompletedHandlerVtbl
+	callback func(errorCode uintptr, cookieList *ICoreWebView2CookieList)
+}
+
+func _ICoreWebView2GetCookiesCompletedHandlerIUnknownQueryInterface(this *iCoreWebView2GetCookiesCompletedHandler, refiid, object uintptr) uintptr {
+	return 0
+}
+
+func _ICoreWebView2GetCookiesCompletedHandlerIUnknownAddRef(this *iCoreWebView2GetCookiesCompletedHandler) uintptr {
+	return 1
+}
+
+func _ICoreWebView2GetCookiesCompletedHandlerIUnknownRelease(this *iCoreWebView2GetCookiesCompletedHandler) uintptr {
+	return 1
+}
+
+func _ICoreWebView2GetCookiesCompletedHandlerInvoke(this *iCoreWebView2GetCookiesCompletedHandler, errorCode uintptr, cookieList *ICoreWebView2CookieList) uintptr {
+	unpin(unsafe.Pointer(this))
+	this.callback(errorCode, cookieList)
+	return 0
+}
+
+var _ICoreWebView2GetCookiesCompletedHandlerFn = _ICoreWebView2GetCookiesCompletedHandlerVtbl{
+	_IUnknownVtbl{
+		NewComProc(_ICoreWebView2GetCookiesCompletedHandlerIUnknownQueryInterface),
+		NewComProc(_ICoreWebView2GetCookiesCompletedHandlerIUnknownAddRef),
+		NewComProc(_ICoreWebView2GetCookiesCompletedHandlerIUnknownRelease),
+	},
+	NewComProc(_ICoreWebView2GetCookiesCompletedHandlerInvoke),
+}
+
+func newICoreWebView2GetCookiesCompletedHandler(callback func(errorCode uintptr, cookieList *ICoreWebView2CookieList)) *iCoreWebView2GetCookiesCompletedHandler {
+	h := &iCoreWebView2GetCookiesCompletedHandler{
+		vtbl:     &_ICoreWebView2GetCookiesCompletedHandlerFn,
+		callback: callback,
+	}
+	pin(unsafe.Pointer(h))
+	return h
+}
@@ -0,0 +1,83 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2HttpRequestHeadersVtbl struct {
+	_IUnknownVtbl
+	GetHeader    ComProc
+	GetHeaders   ComProc
+	Contains     ComProc
+	SetHeader    ComProc
+	RemoveHeader ComProc
+	GetIterator  ComProc
+}
+
+type ICoreWebView2HttpRequestHeaders struct {
+	vtbl *_ICoreWebView2HttpRequestHeadersVtbl
+}
+
+func (i *ICoreWebView2HttpRequestHeaders) Release() {
+	release(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2HttpRequestHeaders) GetIterator() (*ICoreWebView2HttpHeadersCollectionIterator, error) {
+	var err error
+	var iterator *ICoreWebView2HttpHeadersCollectionIterator
+	_, _, err = i.vtbl.GetIterator.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&iterator)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	return iterator, nil
+}
+
+func (i *ICoreWebView2HttpRequestHeaders) SetHeader(name, value string) error {
+	_name, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return err
+	}
+	_value, err := windows.UTF16PtrFromString(value)
+	if err != nil {
+		return err
+	}
+	_, _, err = i.vtbl.SetHeader.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_name)),
+		uintptr(unsafe.Pointer(_value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2HttpRequestHeaders) RemoveHeader(name string) error {
+	_name, err := windows.UTF16PtrFromString(name)
+	if err != nil {
+		return err
+	}
+	_, _, err = i.vtbl.RemoveHeader.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_name)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+// ReadAll returns the headers as a map from name to value.
+func (i *ICoreWebView2HttpRequestHeaders) ReadAll() (map[string]string, error) {
+	iterator, err := i.GetIterator()
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Release()
+	return iterator.ReadAll()
+}
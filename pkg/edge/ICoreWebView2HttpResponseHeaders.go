@@ -0,0 +1,48 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2HttpResponseHeadersVtbl struct {
+	_IUnknownVtbl
+	AppendHeader ComProc
+	Contains     ComProc
+	GetHeader    ComProc
+	GetHeaders   ComProc
+	GetIterator  ComProc
+}
+
+type ICoreWebView2HttpResponseHeaders struct {
+	vtbl *_ICoreWebView2HttpResponseHeadersVtbl
+}
+
+func (i *ICoreWebView2HttpResponseHeaders) Release() {
+	release(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2HttpResponseHeaders) GetIterator() (*ICoreWebView2HttpHeadersCollectionIterator, error) {
+	var err error
+	var iterator *ICoreWebView2HttpHeadersCollectionIterator
+	_, _, err = i.vtbl.GetIterator.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&iterator)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	return iterator, nil
+}
+
+// ReadAll returns the headers as a map from name to value. Of headers that
+// appear more than once, such as Set-Cookie, only the last value is kept.
+func (i *ICoreWebView2HttpResponseHeaders) ReadAll() (map[string]string, error) {
+	iterator, err := i.GetIterator()
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Release()
+	return iterator.ReadAll()
+}
@@ -1,5 +1,11 @@
 package edge
 
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
 type _ICoreWebView2NavigationCompletedEventArgsVtbl struct {
 	_IUnknownVtbl
 	GetIsSuccess      ComProc
@@ -14,3 +20,62 @@ type ICoreWebView2NavigationCompletedEventArgs struct {
 func (i *ICoreWebView2NavigationCompletedEventArgs) AddRef() uintptr {
 	return i.AddRef()
 }
+
+func (i *ICoreWebView2NavigationCompletedEventArgs) GetIsSuccess() (bool, error) {
+	var err error
+	var isSuccess int32
+	_, _, err = i.vtbl.GetIsSuccess.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&isSuccess)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	return isSuccess != 0, nil
+}
+
+func (i *ICoreWebView2NavigationCompletedEventArgs) GetWebErrorStatus() (COREWEBVIEW2_WEB_ERROR_STATUS, error) {
+	var err error
+	var webErrorStatus COREWEBVIEW2_WEB_ERROR_STATUS
+	_, _, err = i.vtbl.GetWebErrorStatus.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&webErrorStatus)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	return webErrorStatus, nil
+}
+
+// GetHttpStatusCode returns the HTTP status code of the navigation. It needs
+// ICoreWebView2NavigationCompletedEventArgs2 and returns ErrNotSupported on
+// runtimes that lack it.
+func (i *ICoreWebView2NavigationCompletedEventArgs) GetHttpStatusCode() (int32, error) {
+	var args2 *iCoreWebView2NavigationCompletedEventArgs2
+	if !queryInterface(unsafe.Pointer(i), &iidICoreWebView2NavigationCompletedEventArgs2, unsafe.Pointer(&args2)) {
+		return 0, ErrNotSupported
+	}
+	defer release(unsafe.Pointer(args2))
+
+	var err error
+	var httpStatusCode int32
+	_, _, err = args2.vtbl.GetHttpStatusCode.Call(
+		uintptr(unsafe.Pointer(args2)),
+		uintptr(unsafe.Pointer(&httpStatusCode)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	return httpStatusCode, nil
+}
+
+var iidICoreWebView2NavigationCompletedEventArgs2 = windows.GUID{Data1: 0xFDF8B738, Data2: 0xEE1E, Data3: 0x4DB2, Data4: [8]byte{0xA3, 0x29, 0x8D, 0x7D, 0x7B, 0x74, 0xD7, 0x92}}
+
+type _ICoreWebView2NavigationCompletedEventArgs2Vtbl struct {
+	_ICoreWebView2NavigationCompletedEventArgsVtbl
+	GetHttpStatusCode ComProc
+}
+
+type iCoreWebView2NavigationCompletedEventArgs2 struct {
+	vtbl *_ICoreWebView2NavigationCompletedEventArgs2Vtbl
+}
@@ -0,0 +1,76 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2NavigationStartingEventArgsVtbl struct {
+	_IUnknownVtbl
+	GetUri             ComProc
+	GetIsUserInitiated ComProc
+	GetIsRedirected    ComProc
+	GetRequestHeaders  ComProc
+	GetCancel          ComProc
+	PutCancel          ComProc
+	GetNavigationId    ComProc
+}
+
+type ICoreWebView2NavigationStartingEventArgs struct {
+	vtbl *_ICoreWebView2NavigationStartingEventArgsVtbl
+}
+
+func (i *ICoreWebView2NavigationStartingEventArgs) GetUri() (string, error) {
+	var err error
+	var _uri *uint16
+	_, _, err = i.vtbl.GetUri.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_uri)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	uri := windows.UTF16PtrToString(_uri)
+	windows.CoTaskMemFree(unsafe.Pointer(_uri))
+	return uri, nil
+}
+
+func (i *ICoreWebView2NavigationStartingEventArgs) GetIsUserInitiated() (bool, error) {
+	var err error
+	var isUserInitiated int32
+	_, _, err = i.vtbl.GetIsUserInitiated.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&isUserInitiated)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	return isUserInitiated != 0, nil
+}
+
+func (i *ICoreWebView2NavigationStartingEventArgs) GetIsRedirected() (bool, error) {
+	var err error
+	var isRedirected int32
+	_, _, err = i.vtbl.GetIsRedirected.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&isRedirected)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	return isRedirected != 0, nil
+}
+
+func (i *ICoreWebView2NavigationStartingEventArgs) PutCancel(cancel bool) error {
+	var err error
+
+	_, _, err = i.vtbl.PutCancel.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(cancel)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2NewWindowRequestedEventArgsVtbl struct {
+	_IUnknownVtbl
+	GetUri             ComProc
+	PutNewWindow       ComProc
+	GetNewWindow       ComProc
+	PutHandled         ComProc
+	GetHandled         ComProc
+	GetIsUserInitiated ComProc
+	GetDeferral        ComProc
+	GetWindowFeatures  ComProc
+}
+
+type ICoreWebView2NewWindowRequestedEventArgs struct {
+	vtbl *_ICoreWebView2NewWindowRequestedEventArgsVtbl
+}
+
+func (i *ICoreWebView2NewWindowRequestedEventArgs) AddRef() {
+	addRef(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2NewWindowRequestedEventArgs) Release() {
+	release(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2NewWindowRequestedEventArgs) GetUri() (string, error) {
+	var err error
+	var _uri *uint16
+	_, _, err = i.vtbl.GetUri.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_uri)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	uri := windows.UTF16PtrToString(_uri)
+	windows.CoTaskMemFree(unsafe.Pointer(_uri))
+	return uri, nil
+}
+
+func (i *ICoreWebView2NewWindowRequestedEventArgs) PutNewWindow(newWindow *ICoreWebView2) error {
+	var err error
+
+	_, _, err = i.vtbl.PutNewWindow.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(newWindow)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2NewWindowRequestedEventArgs) PutHandled(handled bool) error {
+	var err error
+
+	_, _, err = i.vtbl.PutHandled.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(handled)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2NewWindowRequestedEventArgs) GetDeferral() (*ICoreWebView2Deferral, error) {
+	var err error
+	var deferral *ICoreWebView2Deferral
+	_, _, err = i.vtbl.GetDeferral.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&deferral)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	return deferral, nil
+}
@@ -0,0 +1,83 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2PermissionRequestedEventArgsVtbl struct {
+	_IUnknownVtbl
+	GetUri             ComProc
+	GetPermissionKind  ComProc
+	GetIsUserInitiated ComProc
+	GetState           ComProc
+	PutState           ComProc
+	GetDeferral        ComProc
+}
+
+type ICoreWebView2PermissionRequestedEventArgs struct {
+	vtbl *_ICoreWebView2PermissionRequestedEventArgsVtbl
+}
+
+func (i *ICoreWebView2PermissionRequestedEventArgs) AddRef() {
+	addRef(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2PermissionRequestedEventArgs) Release() {
+	release(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2PermissionRequestedEventArgs) GetUri() (string, error) {
+	var err error
+	var _uri *uint16
+	_, _, err = i.vtbl.GetUri.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_uri)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	uri := windows.UTF16PtrToString(_uri)
+	windows.CoTaskMemFree(unsafe.Pointer(_uri))
+	return uri, nil
+}
+
+func (i *ICoreWebView2PermissionRequestedEventArgs) GetPermissionKind() (COREWEBVIEW2_PERMISSION_KIND, error) {
+	var err error
+	var kind COREWEBVIEW2_PERMISSION_KIND
+	_, _, err = i.vtbl.GetPermissionKind.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&kind)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	return kind, nil
+}
+
+func (i *ICoreWebView2PermissionRequestedEventArgs) PutState(state COREWEBVIEW2_PERMISSION_STATE) error {
+	var err error
+
+	_, _, err = i.vtbl.PutState.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(state),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2PermissionRequestedEventArgs) GetDeferral() (*ICoreWebView2Deferral, error) {
+	var err error
+	var deferral *ICoreWebView2Deferral
+	_, _, err = i.vtbl.GetDeferral.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&deferral)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	return deferral, nil
+}
@@ -0,0 +1,51 @@
+package edge
+
+import (
+	"unsafe"
+)
+
+type _ICoreWebView2PrintCompletedHandlerVtbl struct {
+	_IUnknownVtbl
+	Invoke ComProc
+}
+
+type iCoreWebView2PrintCompletedHandler struct {
+	vtbl     *_ICoreWebView2PrintCompletedHandlerVtbl
+	callback func(errorCode uintptr, printStatus COREWEBVIEW2_PRINT_STATUS)
+}
+
+func _ICoreWebView2PrintCompletedHandlerIUnknownQueryInterface(this *iCoreWebView2PrintCompletedHandler, refiid, object uintptr) uintptr {
+	return 0
+}
+
+func _ICoreWebView2PrintCompletedHandlerIUnknownAddRef(this *iCoreWebView2PrintCompletedHandler) uintptr {
+	return 1
+}
+
+func _ICoreWebView2PrintCompletedHandlerIUnknownRelease(this *iCoreWebView2PrintCompletedHandler) uintptr {
+	return 1
+}
+
+func _ICoreWebView2PrintCompletedHandlerInvoke(this *iCoreWebView2PrintCompletedHandler, errorCode, printStatus uintptr) uintptr {
+	unpin(unsafe.Pointer(this))
+	this.callback(errorCode, COREWEBVIEW2_PRINT_STATUS(printStatus))
+	return 0
+}
+
+var _ICoreWebView2PrintCompletedHandlerFn = _ICoreWebView2PrintCompletedHandlerVtbl{
+	_IUnknownVtbl{
+		NewComProc(_ICoreWebView2PrintCompletedHandlerIUnknownQueryInterface),
+		NewComProc(_ICoreWebView2PrintCompletedHandlerIUnknownAddRef),
+		NewComProc(_ICoreWebView2PrintCompletedHandlerIUnknownRelease),
+	},
+	NewComProc(_ICoreWebView2PrintCompletedHandlerInvoke),
+}
+
+func newICoreWebView2PrintCompletedHandler(callback func(errorCode uintptr, printStatus COREWEBVIEW2_PRINT_STATUS)) *iCoreWebView2PrintCompletedHandler {
+	h := &iCoreWebView2PrintCompletedHandler{
+		vtbl:     &_ICoreWebView2PrintCompletedHandlerFn,
+		callback: callback,
+	}
+	pin(unsafe.Pointer(h))
+	return h
+}
@@ -0,0 +1,111 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2PrintSettingsVtbl struct {
+	_IUnknownVtbl
+	GetOrientation                ComProc
+	PutOrientation                ComProc
+	GetScaleFactor                ComProc
+	PutScaleFactor                ComProc
+	GetPageWidth                  ComProc
+	PutPageWidth                  ComProc
+	GetPageHeight                 ComProc
+	PutPageHeight                 ComProc
+	GetMarginTop                  ComProc
+	PutMarginTop                  ComProc
+	GetMarginBottom               ComProc
+	PutMarginBottom               ComProc
+	GetMarginLeft                 ComProc
+	PutMarginLeft                 ComProc
+	GetMarginRight                ComProc
+	PutMarginRight                ComProc
+	GetShouldPrintBackgrounds     ComProc
+	PutShouldPrintBackgrounds     ComProc
+	GetShouldPrintSelectionOnly   ComProc
+	PutShouldPrintSelectionOnly   ComProc
+	GetShouldPrintHeaderAndFooter ComProc
+	PutShouldPrintHeaderAndFooter ComProc
+	GetHeaderTitle                ComProc
+	PutHeaderTitle                ComProc
+	GetFooterUri                  ComProc
+	PutFooterUri                  ComProc
+}
+
+// ICoreWebView2PrintSettings configures PrintToPdf. Sizes and margins are in
+// inches.
+type ICoreWebView2PrintSettings struct {
+	vtbl *_ICoreWebView2PrintSettingsVtbl
+}
+
+func (i *ICoreWebView2PrintSettings) Release() {
+	release(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2PrintSettings) putBool(proc ComProc, value bool) error {
+	var err error
+	_, _, err = proc.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2PrintSettings) PutOrientation(orientation COREWEBVIEW2_PRINT_ORIENTATION) error {
+	var err error
+	_, _, err = i.vtbl.PutOrientation.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(orientation),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2PrintSettings) PutScaleFactor(scaleFactor float64) error {
+	return putFloat64(i.vtbl.PutScaleFactor, unsafe.Pointer(i), scaleFactor)
+}
+
+func (i *ICoreWebView2PrintSettings) PutPageWidth(pageWidth float64) error {
+	return putFloat64(i.vtbl.PutPageWidth, unsafe.Pointer(i), pageWidth)
+}
+
+func (i *ICoreWebView2PrintSettings) PutPageHeight(pageHeight float64) error {
+	return putFloat64(i.vtbl.PutPageHeight, unsafe.Pointer(i), pageHeight)
+}
+
+func (i *ICoreWebView2PrintSettings) PutMarginTop(marginTop float64) error {
+	return putFloat64(i.vtbl.PutMarginTop, unsafe.Pointer(i), marginTop)
+}
+
+func (i *ICoreWebView2PrintSettings) PutMarginBottom(marginBottom float64) error {
+	return putFloat64(i.vtbl.PutMarginBottom, unsafe.Pointer(i), marginBottom)
+}
+
+func (i *ICoreWebView2PrintSettings) PutMarginLeft(marginLeft float64) error {
+	return putFloat64(i.vtbl.PutMarginLeft, unsafe.Pointer(i), marginLeft)
+}
+
+func (i *ICoreWebView2PrintSettings) PutMarginRight(marginRight float64) error {
+	return putFloat64(i.vtbl.PutMarginRight, unsafe.Pointer(i), marginRight)
+}
+
+func (i *ICoreWebView2PrintSettings) PutShouldPrintBackgrounds(shouldPrintBackgrounds bool) error {
+	return i.putBool(i.vtbl.PutShouldPrintBackgrounds, shouldPrintBackgrounds)
+}
+
+func (i *ICoreWebView2PrintSettings) PutShouldPrintSelectionOnly(shouldPrintSelectionOnly bool) error {
+	return i.putBool(i.vtbl.PutShouldPrintSelectionOnly, shouldPrintSelectionOnly)
+}
+
+func (i *ICoreWebView2PrintSettings) PutShouldPrintHeaderAndFooter(shouldPrintHeaderAndFooter bool) error {
+	return i.putBool(i.vtbl.PutShouldPrintHeaderAndFooter, shouldPrintHeaderAndFooter)
+}
@@ -0,0 +1,101 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var iidICoreWebView2PrintSettings2 = windows.GUID{Data1: 0xCA7F0E1F, Data2: 0x3484, Data3: 0x41D1, Data4: [8]byte{0x8C, 0x1A, 0x65, 0xCD, 0x44, 0xA6, 0x3F, 0x8D}}
+
+type _ICoreWebView2PrintSettings2Vtbl struct {
+	_ICoreWebView2PrintSettingsVtbl
+	GetPageRanges   ComProc
+	PutPageRanges   ComProc
+	GetPagesPerSide ComProc
+	PutPagesPerSide ComProc
+	GetCopies       ComProc
+	PutCopies       ComProc
+	GetCollation    ComProc
+	PutCollation    ComProc
+	GetColorMode    ComProc
+	PutColorMode    ComProc
+	GetDuplex       ComProc
+	PutDuplex       ComProc
+	GetMediaSize    ComProc
+	PutMediaSize    ComProc
+	GetPrinterName  ComProc
+	PutPrinterName  ComProc
+}
+
+// ICoreWebView2PrintSettings2 adds the settings of printing to a printer.
+type ICoreWebView2PrintSettings2 struct {
+	vtbl *_ICoreWebView2PrintSettings2Vtbl
+}
+
+// GetICoreWebView2PrintSettings2 returns the ICoreWebView2PrintSettings2
+// interface of the settings, or nil if the installed runtime does not
+// implement it. The caller must Release the result.
+func (i *ICoreWebView2PrintSettings) GetICoreWebView2PrintSettings2() *ICoreWebView2PrintSettings2 {
+	var result *ICoreWebView2PrintSettings2
+	if !queryInterface(unsafe.Pointer(i), &iidICoreWebView2PrintSettings2, unsafe.Pointer(&result)) {
+		return nil
+	}
+	return result
+}
+
+func (i *ICoreWebView2PrintSettings2) Release() {
+	release(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2PrintSettings2) putString(proc ComProc, value string) error {
+	_value, err := windows.UTF16PtrFromString(value)
+	if err != nil {
+		return err
+	}
+	_, _, err = proc.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2PrintSettings2) putUint32(proc ComProc, value uint32) error {
+	var err error
+	_, _, err = proc.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(value),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+// PutPageRanges selects the pages to print, such as "1-3, 5".
+func (i *ICoreWebView2PrintSettings2) PutPageRanges(pageRanges string) error {
+	return i.putString(i.vtbl.PutPageRanges, pageRanges)
+}
+
+func (i *ICoreWebView2PrintSettings2) PutCopies(copies int32) error {
+	return i.putUint32(i.vtbl.PutCopies, uint32(copies))
+}
+
+func (i *ICoreWebView2PrintSettings2) PutCollation(collation COREWEBVIEW2_PRINT_COLLATION) error {
+	return i.putUint32(i.vtbl.PutCollation, uint32(collation))
+}
+
+func (i *ICoreWebView2PrintSettings2) PutColorMode(colorMode COREWEBVIEW2_PRINT_COLOR_MODE) error {
+	return i.putUint32(i.vtbl.PutColorMode, uint32(colorMode))
+}
+
+func (i *ICoreWebView2PrintSettings2) PutDuplex(duplex COREWEBVIEW2_PRINT_DUPLEX) error {
+	return i.putUint32(i.vtbl.PutDuplex, uint32(duplex))
+}
+
+func (i *ICoreWebView2PrintSettings2) PutPrinterName(printerName string) error {
+	return i.putString(i.vtbl.PutPrinterName, printerName)
+}
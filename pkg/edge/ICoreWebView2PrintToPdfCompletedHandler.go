@@ -0,0 +1,51 @@
+package edge
+
+import (
+	"unsafe"
+)
+
+type _ICoreWebView2PrintToPdfCompletedHandlerVtbl struct {
+	_IUnknownVtbl
+	Invoke ComProc
+}
+
+type iCoreWebView2PrintToPdfCompletedHandler struct {
+	vtbl     *_ICoreWebView2PrintToPdfCompletedHandlerVtbl
+	callback func(errorCode uintptr, isSuccessful bool)
+}
+
+func _ICoreWebView2PrintToPdfCompletedHandlerIUnknownQueryInterface(this *iCoreWebView2PrintToPdfCompletedHandler, refiid, object uintptr) uintptr {
+	return 0
+}
+
+func _ICoreWebView2PrintToPdfCompletedHandlerIUnknownAddRef(this *iCoreWebView2PrintToPdfCompletedHandler) uintptr {
+	return 1
+}
+
+func _ICoreWebView2PrintToPdfCompletedHandlerIUnknownRelease(this *iCoreWebView2PrintToPdfCompletedHandler) uintptr {
+	return 1
+}
+
+func _ICoreWebView2PrintToPdfCompletedHandlerInvoke(this *iCoreWebView2PrintToPdfCompletedHandler, errorCode, isSuccessful uintptr) uintptr {
+	unpin(unsafe.Pointer(this))
+	this.callback(errorCode, isSuccessful != 0)
+	return 0
+}
+
+var _ICoreWebView2PrintToPdfCompletedHandlerFn = _ICoreWebView2PrintToPdfCompletedHandlerVtbl{
+	_IUnknownVtbl{
+		NewComProc(_ICoreWebView2PrintToPdfCompletedHandlerIUnknownQueryInterface),
+		NewComProc(_ICoreWebView2PrintToPdfCompletedHandlerIUnknownAddRef),
+		NewComProc(_ICoreWebView2PrintToPdfCompletedHandlerIUnknownRelease),
+	},
+	NewComProc(_ICoreWebView2PrintToPdfCompletedHandlerInvoke),
+}
+
+func newICoreWebView2PrintToPdfCompletedHandler(callback func(errorCode uintptr, isSuccessful bool)) *iCoreWebView2PrintToPdfCompletedHandler {
+	h := &iCoreWebView2PrintToPdfCompletedHandler{
+		vtbl:     &_ICoreWebView2PrintToPdfCompletedHandlerFn,
+		callback: callback,
+	}
+	pin(unsafe.Pointer(h))
+	return h
+}
@@ -0,0 +1,51 @@
+package edge
+
+import (
+	"unsafe"
+)
+
+type _ICoreWebView2PrintToPdfStreamCompletedHandlerVtbl struct {
+	_IUnknownVtbl
+	Invoke ComProc
+}
+
+type iCoreWebView2PrintToPdfStreamCompletedHandler struct {
+	vtbl     *_ICoreWebView2PrintToPdfStreamCompletedHandlerVtbl
+	callback func(errorCode uintptr, stream *IStream)
+}
+
+func _ICoreWebView2PrintToPdfStreamCompletedHandlerIUnknownQueryInterface(this *iCoreWebView2PrintToPdfStreamCompletedHandler, refiid, object uintptr) uintptr {
+	return 0
+}
+
+func _ICoreWebView2PrintToPdfStreamCompletedHandlerIUnknownAddRef(this *iCoreWebView2PrintToPdfStreamCompletedHandler) uintptr {
+	return 1
+}
+
+func _ICoreWebView2PrintToPdfStreamCompletedHandlerIUnknownRelease(this *iCoreWebView2PrintToPdfStreamCompletedHandler) uintptr {
+	return 1
+}
+
+func _ICoreWebView2PrintToPdfStreamCompletedHandlerInvoke(this *iCoreWebView2PrintToPdfStreamCompletedHandler, errorCode uintptr, stream *IStream) uintptr {
+	unpin(unsafe.Pointer(this))
+	this.callback(errorCode, stream)
+	return 0
+}
+
+var _ICoreWebView2PrintToPdfStreamCompletedHandlerFn = _ICoreWebView2PrintToPdfStreamCompletedHandlerVtbl{
+	_IUnknownVtbl{
+		NewComProc(_ICoreWebView2PrintToPdfStreamCompletedHandlerIUnknownQueryInterface),
+		NewComProc(_ICoreWebView2PrintToPdfStreamCompletedHandlerIUnknownAddRef),
+		NewComProc(_ICoreWebView2PrintToPdfStreamCompletedHandlerIUnknownRelease),
+	},
+	NewComProc(_ICoreWebView2PrintToPdfStreamCompletedHandlerInvoke),
+}
+
+func newICoreWebView2PrintToPdfStreamCompletedHandler(callback func(errorCode uintptr, stream *IStream)) *iCoreWebView2PrintToPdfStreamCompletedHandler {
+	h := &iCoreWebView2PrintToPdfStreamCompletedHandler{
+		vtbl:     &_ICoreWebView2PrintToPdfStreamCompletedHandlerFn,
+		callback: callback,
+	}
+	pin(unsafe.Pointer(h))
+	return h
+}
@@ -0,0 +1,87 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2ProcessFailedEventArgsVtbl struct {
+	_IUnknownVtbl
+	GetProcessFailedKind ComProc
+}
+
+type ICoreWebView2ProcessFailedEventArgs struct {
+	vtbl *_ICoreWebView2ProcessFailedEventArgsVtbl
+}
+
+func (i *ICoreWebView2ProcessFailedEventArgs) GetProcessFailedKind() (COREWEBVIEW2_PROCESS_FAILED_KIND, error) {
+	var err error
+	var kind COREWEBVIEW2_PROCESS_FAILED_KIND
+	_, _, err = i.vtbl.GetProcessFailedKind.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&kind)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	return kind, nil
+}
+
+// GetReason returns why the process failed. It needs
+// ICoreWebView2ProcessFailedEventArgs2 and returns ErrNotSupported on runtimes
+// that lack it.
+func (i *ICoreWebView2ProcessFailedEventArgs) GetReason() (COREWEBVIEW2_PROCESS_FAILED_REASON, error) {
+	var args2 *iCoreWebView2ProcessFailedEventArgs2
+	if !queryInterface(unsafe.Pointer(i), &iidICoreWebView2ProcessFailedEventArgs2, unsafe.Pointer(&args2)) {
+		return 0, ErrNotSupported
+	}
+	defer release(unsafe.Pointer(args2))
+
+	var err error
+	var reason COREWEBVIEW2_PROCESS_FAILED_REASON
+	_, _, err = args2.vtbl.GetReason.Call(
+		uintptr(unsafe.Pointer(args2)),
+		uintptr(unsafe.Pointer(&reason)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	return reason, nil
+}
+
+// GetExitCode returns the exit code of the failed process. It needs
+// ICoreWebView2ProcessFailedEventArgs2 and returns ErrNotSupported on runtimes
+// that lack it.
+func (i *ICoreWebView2ProcessFailedEventArgs) GetExitCode() (int32, error) {
+	var args2 *iCoreWebView2ProcessFailedEventArgs2
+	if !queryInterface(unsafe.Pointer(i), &iidICoreWebView2ProcessFailedEventArgs2, unsafe.Pointer(&args2)) {
+		return 0, ErrNotSupported
+	}
+	defer release(unsafe.Pointer(args2))
+
+	var err error
+	var exitCode int32
+	_, _, err = args2.vtbl.GetExitCode.Call(
+		uintptr(unsafe.Pointer(args2)),
+		uintptr(unsafe.Pointer(&exitCode)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	return exitCode, nil
+}
+
+var iidICoreWebView2ProcessFailedEventArgs2 = windows.GUID{Data1: 0x4DAB9422, Data2: 0x46FA, Data3: 0x4C3E, Data4: [8]byte{0xA5, 0xD2, 0x41, 0xD2, 0x07, 0x1D, 0x36, 0x80}}
+
+type _ICoreWebView2ProcessFailedEventArgs2Vtbl struct {
+	_ICoreWebView2ProcessFailedEventArgsVtbl
+	GetReason                     ComProc
+	GetExitCode                   ComProc
+	GetProcessDescription         ComProc
+	GetFrameInfosForFailedProcess ComProc
+}
+
+type iCoreWebView2ProcessFailedEventArgs2 struct {
+	vtbl *_ICoreWebView2ProcessFailedEventArgs2Vtbl
+}
@@ -0,0 +1,81 @@
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2ProfileVtbl struct {
+	_IUnknownVtbl
+	GetProfileName               ComProc
+	GetIsInPrivateModeEnabled    ComProc
+	GetProfilePath               ComProc
+	GetDefaultDownloadFolderPath ComProc
+	PutDefaultDownloadFolderPath ComProc
+	GetPreferredColorScheme      ComProc
+	PutPreferredColorScheme      ComProc
+}
+
+type ICoreWebView2Profile struct {
+	vtbl *_ICoreWebView2ProfileVtbl
+}
+
+func (i *ICoreWebView2Profile) Release() {
+	release(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2Profile) GetIsInPrivateModeEnabled() (bool, error) {
+	var err error
+	var isInPrivateModeEnabled int32
+	_, _, err = i.vtbl.GetIsInPrivateModeEnabled.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&isInPrivateModeEnabled)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	return isInPrivateModeEnabled != 0, nil
+}
+
+func (i *ICoreWebView2Profile) GetDefaultDownloadFolderPath() (string, error) {
+	var err error
+	var _path *uint16
+	_, _, err = i.vtbl.GetDefaultDownloadFolderPath.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_path)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	path := windows.UTF16PtrToString(_path)
+	windows.CoTaskMemFree(unsafe.Pointer(_path))
+	return path, nil
+}
+
+func (i *ICoreWebView2Profile) PutDefaultDownloadFolderPath(path string) error {
+	_path, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return err
+	}
+	hr, _, _ := i.vtbl.PutDefaultDownloadFolderPath.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_path)),
+	)
+	if int32(hr) < 0 {
+		return syscall.Errno(hr)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2Profile) PutPreferredColorScheme(value COREWEBVIEW2_PREFERRED_COLOR_SCHEME) error {
+	hr, _, _ := i.vtbl.PutPreferredColorScheme.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(value),
+	)
+	if int32(hr) < 0 {
+		return syscall.Errno(hr)
+	}
+	return nil
+}
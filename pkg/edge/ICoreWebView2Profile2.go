@@ -0,0 +1,58 @@
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var iidICoreWebView2Profile2 = windows.GUID{Data1: 0xFA740D4B, Data2: 0x5EAE, Data3: 0x4344, Data4: [8]byte{0xA8, 0xAD, 0x74, 0xBE, 0x31, 0x92, 0x53, 0x97}}
+
+type _ICoreWebView2Profile2Vtbl struct {
+	_ICoreWebView2ProfileVtbl
+	ClearBrowsingData            ComProc
+	ClearBrowsingDataInTimeRange ComProc
+	ClearBrowsingDataAll         ComProc
+}
+
+type ICoreWebView2Profile2 struct {
+	vtbl *_ICoreWebView2Profile2Vtbl
+}
+
+// GetICoreWebView2Profile2 returns the ICoreWebView2Profile2 interface of the
+// profile, or nil if the installed runtime does not implement it. The caller
+// must Release the result.
+func (i *ICoreWebView2Profile) GetICoreWebView2Profile2() *ICoreWebView2Profile2 {
+	var result *ICoreWebView2Profile2
+	if !queryInterface(unsafe.Pointer(i), &iidICoreWebView2Profile2, unsafe.Pointer(&result)) {
+		return nil
+	}
+	return result
+}
+
+func (i *ICoreWebView2Profile2) Release() {
+	release(unsafe.Pointer(i))
+}
+
+// ClearBrowsingData deletes the given kinds of browsing data of the profile
+// and calls completed on the UI thread once it is done.
+func (i *ICoreWebView2Profile2) ClearBrowsingData(dataKinds COREWEBVIEW2_BROWSING_DATA_KINDS, completed func(err error)) error {
+	handler := newICoreWebView2ClearBrowsingDataCompletedHandler(func(errorCode uintptr) {
+		if int32(errorCode) < 0 {
+			completed(syscall.Errno(errorCode))
+			return
+		}
+		completed(nil)
+	})
+	hr, _, _ := i.vtbl.ClearBrowsingData.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(dataKinds),
+		uintptr(unsafe.Pointer(handler)),
+	)
+	if int32(hr) < 0 {
+		unpin(unsafe.Pointer(handler))
+		return syscall.Errno(hr)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2ScriptDialogOpeningEventArgsVtbl struct {
+	_IUnknownVtbl
+	GetUri         ComProc
+	GetKind        ComProc
+	GetMessage     ComProc
+	Accept         ComProc
+	GetDefaultText ComProc
+	GetResultText  ComProc
+	PutResultText  ComProc
+	GetDeferral    ComProc
+}
+
+type ICoreWebView2ScriptDialogOpeningEventArgs struct {
+	vtbl *_ICoreWebView2ScriptDialogOpeningEventArgsVtbl
+}
+
+func (i *ICoreWebView2ScriptDialogOpeningEventArgs) AddRef() {
+	addRef(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2ScriptDialogOpeningEventArgs) Release() {
+	release(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2ScriptDialogOpeningEventArgs) GetUri() (string, error) {
+	return i.getString(i.vtbl.GetUri)
+}
+
+func (i *ICoreWebView2ScriptDialogOpeningEventArgs) GetKind() (COREWEBVIEW2_SCRIPT_DIALOG_KIND, error) {
+	var err error
+	var kind COREWEBVIEW2_SCRIPT_DIALOG_KIND
+	_, _, err = i.vtbl.GetKind.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&kind)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	return kind, nil
+}
+
+func (i *ICoreWebView2ScriptDialogOpeningEventArgs) GetMessage() (string, error) {
+	return i.getString(i.vtbl.GetMessage)
+}
+
+func (i *ICoreWebView2ScriptDialogOpeningEventArgs) GetDefaultText() (string, error) {
+	return i.getString(i.vtbl.GetDefaultText)
+}
+
+// Accept answers the dialog as if the user clicked OK. Dialogs that are not
+// accepted are treated as cancelled.
+func (i *ICoreWebView2ScriptDialogOpeningEventArgs) Accept() error {
+	var err error
+	_, _, err = i.vtbl.Accept.Call(
+		uintptr(unsafe.Pointer(i)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2ScriptDialogOpeningEventArgs) PutResultText(resultText string) error {
+	var err error
+	_resultText, err := windows.UTF16PtrFromString(resultText)
+	if err != nil {
+		return err
+	}
+	_, _, err = i.vtbl.PutResultText.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_resultText)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2ScriptDialogOpeningEventArgs) GetDeferral() (*ICoreWebView2Deferral, error) {
+	var err error
+	var deferral *ICoreWebView2Deferral
+	_, _, err = i.vtbl.GetDeferral.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&deferral)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	return deferral, nil
+}
+
+func (i *ICoreWebView2ScriptDialogOpeningEventArgs) getString(proc ComProc) (string, error) {
+	var err error
+	var _value *uint16
+	_, _, err = proc.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	value := windows.UTF16PtrToString(_value)
+	windows.CoTaskMemFree(unsafe.Pointer(_value))
+	return value, nil
+}
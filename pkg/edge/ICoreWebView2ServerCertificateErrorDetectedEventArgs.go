@@ -0,0 +1,61 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2ServerCertificateErrorDetectedEventArgsVtbl struct {
+	_IUnknownVtbl
+	GetErrorStatus       ComProc
+	GetRequestUri        ComProc
+	GetServerCertificate ComProc
+	GetAction            ComProc
+	PutAction            ComProc
+	GetDeferral          ComProc
+}
+
+type ICoreWebView2ServerCertificateErrorDetectedEventArgs struct {
+	vtbl *_ICoreWebView2ServerCertificateErrorDetectedEventArgsVtbl
+}
+
+func (i *ICoreWebView2ServerCertificateErrorDetectedEventArgs) GetErrorStatus() (COREWEBVIEW2_WEB_ERROR_STATUS, error) {
+	var err error
+	var status COREWEBVIEW2_WEB_ERROR_STATUS
+	_, _, err = i.vtbl.GetErrorStatus.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&status)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	return status, nil
+}
+
+func (i *ICoreWebView2ServerCertificateErrorDetectedEventArgs) GetRequestUri() (string, error) {
+	var err error
+	var _uri *uint16
+	_, _, err = i.vtbl.GetRequestUri.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_uri)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	uri := windows.UTF16PtrToString(_uri)
+	windows.CoTaskMemFree(unsafe.Pointer(_uri))
+	return uri, nil
+}
+
+func (i *ICoreWebView2ServerCertificateErrorDetectedEventArgs) PutAction(action COREWEBVIEW2_SERVER_CERTIFICATE_ERROR_ACTION) error {
+	var err error
+	_, _, err = i.vtbl.PutAction.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(action),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
@@ -32,7 +32,8 @@ type ICoreWebView2Settings struct {
 }
 
 func (i *ICoreWebView2Settings) AddRef() uintptr {
-	return i.AddRef()
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
 }
 
 func (i *ICoreWebView2Settings) GetIsScriptEnabled() (bool, error) {
@@ -0,0 +1,64 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var iidICoreWebView2Settings2 = windows.GUID{Data1: 0xEE9A0F68, Data2: 0xF46C, Data3: 0x4E32, Data4: [8]byte{0xAC, 0x23, 0xEF, 0x8C, 0xAC, 0x22, 0x4D, 0x2A}}
+
+type _ICoreWebView2Settings2Vtbl struct {
+	_ICoreWebView2SettingsVtbl
+	GetUserAgent ComProc
+	PutUserAgent ComProc
+}
+
+type ICoreWebView2Settings2 struct {
+	vtbl *_ICoreWebView2Settings2Vtbl
+}
+
+// GetICoreWebView2Settings2 returns the ICoreWebView2Settings2 interface of
+// the settings, or nil if the installed runtime does not implement it. The
+// caller must Release the result.
+func (i *ICoreWebView2Settings) GetICoreWebView2Settings2() *ICoreWebView2Settings2 {
+	var result *ICoreWebView2Settings2
+	if !queryInterface(unsafe.Pointer(i), &iidICoreWebView2Settings2, unsafe.Pointer(&result)) {
+		return nil
+	}
+	return result
+}
+
+func (i *ICoreWebView2Settings2) Release() {
+	release(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2Settings2) GetUserAgent() (string, error) {
+	var err error
+	var _userAgent *uint16
+	_, _, err = i.vtbl.GetUserAgent.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_userAgent)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	userAgent := windows.UTF16PtrToString(_userAgent)
+	windows.CoTaskMemFree(unsafe.Pointer(_userAgent))
+	return userAgent, nil
+}
+
+func (i *ICoreWebView2Settings2) PutUserAgent(userAgent string) error {
+	_userAgent, err := windows.UTF16PtrFromString(userAgent)
+	if err != nil {
+		return err
+	}
+	_, _, err = i.vtbl.PutUserAgent.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_userAgent)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
@@ -1,8 +1,9 @@
 package edge
 
 import (
-	"golang.org/x/sys/windows"
 	"unsafe"
+
+	"golang.org/x/sys/windows"
 )
 
 type _ICoreWebView2WebResourceRequestVtbl struct {
@@ -21,7 +22,8 @@ type ICoreWebView2WebResourceRequest struct {
 }
 
 func (i *ICoreWebView2WebResourceRequest) AddRef() uintptr {
-	return i.AddRef()
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
 }
 
 func (i *ICoreWebView2WebResourceRequest) GetUri() (string, error) {
@@ -39,3 +41,100 @@ func (i *ICoreWebView2WebResourceRequest) GetUri() (string, error) {
 	windows.CoTaskMemFree(unsafe.Pointer(_uri))
 	return uri, nil
 }
+
+func (i *ICoreWebView2WebResourceRequest) PutUri(uri string) error {
+	_uri, err := windows.UTF16PtrFromString(uri)
+	if err != nil {
+		return err
+	}
+	_, _, err = i.vtbl.PutUri.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_uri)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2WebResourceRequest) GetMethod() (string, error) {
+	var err error
+	var _method *uint16
+	_, _, err = i.vtbl.GetMethod.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_method)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	method := windows.UTF16PtrToString(_method)
+	windows.CoTaskMemFree(unsafe.Pointer(_method))
+	return method, nil
+}
+
+// GetContent returns the request body, or nil if the request has none.
+func (i *ICoreWebView2WebResourceRequest) GetContent() ([]byte, error) {
+	var err error
+	var stream *IStream
+	_, _, err = i.vtbl.GetContent.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&stream)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	if stream == nil {
+		return nil, nil
+	}
+	defer stream.Release()
+	return stream.ReadAll()
+}
+
+func (i *ICoreWebView2WebResourceRequest) PutMethod(method string) error {
+	_method, err := windows.UTF16PtrFromString(method)
+	if err != nil {
+		return err
+	}
+	_, _, err = i.vtbl.PutMethod.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_method)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+// PutContent replaces the request body with content.
+func (i *ICoreWebView2WebResourceRequest) PutContent(content []byte) error {
+	stream, err := NewMemoryStream(content)
+	if err != nil {
+		return err
+	}
+	defer stream.Release()
+	_, _, err = i.vtbl.PutContent.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(stream)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2WebResourceRequest) GetHeaders() (*ICoreWebView2HttpRequestHeaders, error) {
+	var err error
+	var headers *ICoreWebView2HttpRequestHeaders
+	_, _, err = i.vtbl.GetHeaders.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&headers)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	return headers, nil
+}
+
+func (i *ICoreWebView2WebResourceRequest) Release() {
+	release(unsafe.Pointer(i))
+}
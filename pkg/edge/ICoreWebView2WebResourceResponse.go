@@ -1,5 +1,7 @@
 package edge
 
+import "unsafe"
+
 type _ICoreWebView2WebResourceResponseVtbl struct {
 	_IUnknownVtbl
 	GetContent      ComProc
@@ -16,5 +18,10 @@ type ICoreWebView2WebResourceResponse struct {
 }
 
 func (i *ICoreWebView2WebResourceResponse) AddRef() uintptr {
-	return i.AddRef()
+	r, _, _ := i.vtbl.AddRef.Call(uintptr(unsafe.Pointer(i)))
+	return r
+}
+
+func (i *ICoreWebView2WebResourceResponse) Release() {
+	release(unsafe.Pointer(i))
 }
@@ -0,0 +1,66 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+type _ICoreWebView2WebResourceResponseViewVtbl struct {
+	_IUnknownVtbl
+	GetHeaders      ComProc
+	GetStatusCode   ComProc
+	GetReasonPhrase ComProc
+	GetContent      ComProc
+}
+
+// ICoreWebView2WebResourceResponseView is a read only view of a response
+// received by the webview.
+type ICoreWebView2WebResourceResponseView struct {
+	vtbl *_ICoreWebView2WebResourceResponseViewVtbl
+}
+
+func (i *ICoreWebView2WebResourceResponseView) Release() {
+	release(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2WebResourceResponseView) GetHeaders() (*ICoreWebView2HttpResponseHeaders, error) {
+	var err error
+	var headers *ICoreWebView2HttpResponseHeaders
+	_, _, err = i.vtbl.GetHeaders.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&headers)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	return headers, nil
+}
+
+func (i *ICoreWebView2WebResourceResponseView) GetStatusCode() (int32, error) {
+	var err error
+	var statusCode int32
+	_, _, err = i.vtbl.GetStatusCode.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&statusCode)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	return statusCode, nil
+}
+
+func (i *ICoreWebView2WebResourceResponseView) GetReasonPhrase() (string, error) {
+	var err error
+	var _reasonPhrase *uint16
+	_, _, err = i.vtbl.GetReasonPhrase.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_reasonPhrase)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	reasonPhrase := windows.UTF16PtrToString(_reasonPhrase)
+	windows.CoTaskMemFree(unsafe.Pointer(_reasonPhrase))
+	return reasonPhrase, nil
+}
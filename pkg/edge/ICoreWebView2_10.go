@@ -0,0 +1,47 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var iidICoreWebView2_10 = windows.GUID{Data1: 0xB1690564, Data2: 0x6F5A, Data3: 0x4983, Data4: [8]byte{0x8E, 0x48, 0x31, 0xD1, 0x14, 0x3F, 0xEC, 0xDB}}
+
+type iCoreWebView2_10Vtbl struct {
+	iCoreWebView2_9Vtbl
+	AddBasicAuthenticationRequested    ComProc
+	RemoveBasicAuthenticationRequested ComProc
+}
+
+type ICoreWebView2_10 struct {
+	vtbl *iCoreWebView2_10Vtbl
+}
+
+// GetICoreWebView2_10 returns the ICoreWebView2_10 interface of the webview, or
+// nil if the installed runtime does not implement it. The caller must Release
+// the result.
+func (i *ICoreWebView2) GetICoreWebView2_10() *ICoreWebView2_10 {
+	var result *ICoreWebView2_10
+	if !queryInterface(unsafe.Pointer(i), &iidICoreWebView2_10, unsafe.Pointer(&result)) {
+		return nil
+	}
+	return result
+}
+
+func (i *ICoreWebView2_10) Release() {
+	release(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2_10) AddBasicAuthenticationRequested(eventHandler *ICoreWebView2BasicAuthenticationRequestedEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddBasicAuthenticationRequested.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var iidICoreWebView2_11 = windows.GUID{Data1: 0x0BE78E56, Data2: 0xC193, Data3: 0x4051, Data4: [8]byte{0xB9, 0x43, 0x23, 0xB4, 0x60, 0xC0, 0x8B, 0xDB}}
+
+type iCoreWebView2_11Vtbl struct {
+	iCoreWebView2_10Vtbl
+	CallDevToolsProtocolMethodForSession ComProc
+	AddContextMenuRequested              ComProc
+	RemoveContextMenuRequested           ComProc
+}
+
+type ICoreWebView2_11 struct {
+	vtbl *iCoreWebView2_11Vtbl
+}
+
+// GetICoreWebView2_11 returns the ICoreWebView2_11 interface of the webview, or
+// nil if the installed runtime does not implement it. The caller must Release
+// the result.
+func (i *ICoreWebView2) GetICoreWebView2_11() *ICoreWebView2_11 {
+	var result *ICoreWebView2_11
+	if !queryInterface(unsafe.Pointer(i), &iidICoreWebView2_11, unsafe.Pointer(&result)) {
+		return nil
+	}
+	return result
+}
+
+func (i *ICoreWebView2_11) Release() {
+	release(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2_11) AddContextMenuRequested(eventHandler *ICoreWebView2ContextMenuRequestedEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddContextMenuRequested.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
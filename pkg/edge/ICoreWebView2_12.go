@@ -0,0 +1,35 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var iidICoreWebView2_12 = windows.GUID{Data1: 0x35D69927, Data2: 0xBCFA, Data3: 0x4566, Data4: [8]byte{0x93, 0x49, 0x6B, 0x3E, 0x0D, 0x15, 0x4C, 0xAC}}
+
+type iCoreWebView2_12Vtbl struct {
+	iCoreWebView2_11Vtbl
+	AddStatusBarTextChanged    ComProc
+	RemoveStatusBarTextChanged ComProc
+	GetStatusBarText           ComProc
+}
+
+type ICoreWebView2_12 struct {
+	vtbl *iCoreWebView2_12Vtbl
+}
+
+// GetICoreWebView2_12 returns the ICoreWebView2_12 interface of the webview, or
+// nil if the installed runtime does not implement it. The caller must Release
+// the result.
+func (i *ICoreWebView2) GetICoreWebView2_12() *ICoreWebView2_12 {
+	var result *ICoreWebView2_12
+	if !queryInterface(unsafe.Pointer(i), &iidICoreWebView2_12, unsafe.Pointer(&result)) {
+		return nil
+	}
+	return result
+}
+
+func (i *ICoreWebView2_12) Release() {
+	release(unsafe.Pointer(i))
+}
@@ -0,0 +1,48 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var iidICoreWebView2_13 = windows.GUID{Data1: 0xF75F09A8, Data2: 0x667E, Data3: 0x4983, Data4: [8]byte{0x88, 0xD6, 0xC8, 0x77, 0x3F, 0x31, 0x5E, 0x84}}
+
+type iCoreWebView2_13Vtbl struct {
+	iCoreWebView2_12Vtbl
+	GetProfile ComProc
+}
+
+type ICoreWebView2_13 struct {
+	vtbl *iCoreWebView2_13Vtbl
+}
+
+// GetICoreWebView2_13 returns the ICoreWebView2_13 interface of the webview, or
+// nil if the installed runtime does not implement it. The caller must Release
+// the result.
+func (i *ICoreWebView2) GetICoreWebView2_13() *ICoreWebView2_13 {
+	var result *ICoreWebView2_13
+	if !queryInterface(unsafe.Pointer(i), &iidICoreWebView2_13, unsafe.Pointer(&result)) {
+		return nil
+	}
+	return result
+}
+
+func (i *ICoreWebView2_13) Release() {
+	release(unsafe.Pointer(i))
+}
+
+// GetProfile returns the profile the webview runs in. The caller must Release
+// the result.
+func (i *ICoreWebView2_13) GetProfile() (*ICoreWebView2Profile, error) {
+	var err error
+	var profile *ICoreWebView2Profile
+	_, _, err = i.vtbl.GetProfile.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&profile)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	return profile, nil
+}
@@ -0,0 +1,48 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var iidICoreWebView2_14 = windows.GUID{Data1: 0x6DAA4F10, Data2: 0x4A90, Data3: 0x4753, Data4: [8]byte{0x88, 0x98, 0x77, 0xC5, 0xDF, 0x53, 0x41, 0x65}}
+
+type iCoreWebView2_14Vtbl struct {
+	iCoreWebView2_13Vtbl
+	AddServerCertificateErrorDetected    ComProc
+	RemoveServerCertificateErrorDetected ComProc
+	ClearServerCertificateErrorActions   ComProc
+}
+
+type ICoreWebView2_14 struct {
+	vtbl *iCoreWebView2_14Vtbl
+}
+
+// GetICoreWebView2_14 returns the ICoreWebView2_14 interface of the webview, or
+// nil if the installed runtime does not implement it. The caller must Release
+// the result.
+func (i *ICoreWebView2) GetICoreWebView2_14() *ICoreWebView2_14 {
+	var result *ICoreWebView2_14
+	if !queryInterface(unsafe.Pointer(i), &iidICoreWebView2_14, unsafe.Pointer(&result)) {
+		return nil
+	}
+	return result
+}
+
+func (i *ICoreWebView2_14) Release() {
+	release(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2_14) AddServerCertificateErrorDetected(eventHandler *ICoreWebView2ServerCertificateErrorDetectedEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddServerCertificateErrorDetected.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
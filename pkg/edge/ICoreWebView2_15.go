@@ -0,0 +1,36 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var iidICoreWebView2_15 = windows.GUID{Data1: 0x517B2D1D, Data2: 0x7DAE, Data3: 0x4A66, Data4: [8]byte{0xA4, 0xF4, 0x10, 0x35, 0x2F, 0xFB, 0x95, 0x18}}
+
+type iCoreWebView2_15Vtbl struct {
+	iCoreWebView2_14Vtbl
+	AddFaviconChanged    ComProc
+	RemoveFaviconChanged ComProc
+	GetFaviconUri        ComProc
+	GetFavicon           ComProc
+}
+
+type ICoreWebView2_15 struct {
+	vtbl *iCoreWebView2_15Vtbl
+}
+
+// GetICoreWebView2_15 returns the ICoreWebView2_15 interface of the webview, or
+// nil if the installed runtime does not implement it. The caller must Release
+// the result.
+func (i *ICoreWebView2) GetICoreWebView2_15() *ICoreWebView2_15 {
+	var result *ICoreWebView2_15
+	if !queryInterface(unsafe.Pointer(i), &iidICoreWebView2_15, unsafe.Pointer(&result)) {
+		return nil
+	}
+	return result
+}
+
+func (i *ICoreWebView2_15) Release() {
+	release(unsafe.Pointer(i))
+}
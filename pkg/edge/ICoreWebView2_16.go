@@ -0,0 +1,80 @@
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var iidICoreWebView2_16 = windows.GUID{Data1: 0x0EB34DC9, Data2: 0x9F91, Data3: 0x41E1, Data4: [8]byte{0x86, 0x39, 0x95, 0xCD, 0x59, 0x43, 0x90, 0x6B}}
+
+type iCoreWebView2_16Vtbl struct {
+	iCoreWebView2_15Vtbl
+	Print            ComProc
+	ShowPrintUI      ComProc
+	PrintToPdfStream ComProc
+}
+
+type ICoreWebView2_16 struct {
+	vtbl *iCoreWebView2_16Vtbl
+}
+
+// GetICoreWebView2_16 returns the ICoreWebView2_16 interface of the webview, or
+// nil if the installed runtime does not implement it. The caller must Release
+// the result.
+func (i *ICoreWebView2) GetICoreWebView2_16() *ICoreWebView2_16 {
+	var result *ICoreWebView2_16
+	if !queryInterface(unsafe.Pointer(i), &iidICoreWebView2_16, unsafe.Pointer(&result)) {
+		return nil
+	}
+	return result
+}
+
+func (i *ICoreWebView2_16) Release() {
+	release(unsafe.Pointer(i))
+}
+
+// PrintToPdfStream renders the page as a PDF and calls completed on the UI
+// thread with a stream holding it. printSettings may be nil for the defaults.
+func (i *ICoreWebView2_16) PrintToPdfStream(printSettings *ICoreWebView2PrintSettings, completed func(stream *IStream, err error)) error {
+	handler := newICoreWebView2PrintToPdfStreamCompletedHandler(func(errorCode uintptr, stream *IStream) {
+		if int32(errorCode) < 0 {
+			completed(nil, syscall.Errno(errorCode))
+			return
+		}
+		completed(stream, nil)
+	})
+	hr, _, _ := i.vtbl.PrintToPdfStream.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(printSettings)),
+		uintptr(unsafe.Pointer(handler)),
+	)
+	if int32(hr) < 0 {
+		unpin(unsafe.Pointer(handler))
+		return syscall.Errno(hr)
+	}
+	return nil
+}
+
+// Print prints the page without a dialog and calls completed on the UI thread
+// with the result. printSettings may be nil to use the default printer.
+func (i *ICoreWebView2_16) Print(printSettings *ICoreWebView2PrintSettings, completed func(printStatus COREWEBVIEW2_PRINT_STATUS, err error)) error {
+	handler := newICoreWebView2PrintCompletedHandler(func(errorCode uintptr, printStatus COREWEBVIEW2_PRINT_STATUS) {
+		if int32(errorCode) < 0 {
+			completed(COREWEBVIEW2_PRINT_STATUS_OTHER_ERROR, syscall.Errno(errorCode))
+			return
+		}
+		completed(printStatus, nil)
+	})
+	hr, _, _ := i.vtbl.Print.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(printSettings)),
+		uintptr(unsafe.Pointer(handler)),
+	)
+	if int32(hr) < 0 {
+		unpin(unsafe.Pointer(handler))
+		return syscall.Errno(hr)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var iidICoreWebView2_2 = windows.GUID{Data1: 0x9E8F0CF8, Data2: 0xE670, Data3: 0x4B5E, Data4: [8]byte{0xB2, 0xBC, 0x73, 0xE0, 0x61, 0xE3, 0x18, 0x4C}}
+
+type iCoreWebView2_2Vtbl struct {
+	iCoreWebView2Vtbl
+	AddWebResourceResponseReceived    ComProc
+	RemoveWebResourceResponseReceived ComProc
+	NavigateWithWebResourceRequest    ComProc
+	AddDOMContentLoaded               ComProc
+	RemoveDOMContentLoaded            ComProc
+	GetCookieManager                  ComProc
+	GetEnvironment                    ComProc
+}
+
+type ICoreWebView2_2 struct {
+	vtbl *iCoreWebView2_2Vtbl
+}
+
+// GetICoreWebView2_2 returns the ICoreWebView2_2 interface of the webview, or
+// nil if the installed runtime does not implement it. The caller must Release
+// the result.
+func (i *ICoreWebView2) GetICoreWebView2_2() *ICoreWebView2_2 {
+	var result *ICoreWebView2_2
+	if !queryInterface(unsafe.Pointer(i), &iidICoreWebView2_2, unsafe.Pointer(&result)) {
+		return nil
+	}
+	return result
+}
+
+func (i *ICoreWebView2_2) Release() {
+	release(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2_2) NavigateWithWebResourceRequest(request *ICoreWebView2WebResourceRequest) error {
+	var err error
+	_, _, err = i.vtbl.NavigateWithWebResourceRequest.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(request)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2_2) AddWebResourceResponseReceived(eventHandler *ICoreWebView2WebResourceResponseReceivedEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddWebResourceResponseReceived.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2_2) GetCookieManager() (*ICoreWebView2CookieManager, error) {
+	var err error
+	var cookieManager *ICoreWebView2CookieManager
+	_, _, err = i.vtbl.GetCookieManager.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&cookieManager)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	return cookieManager, nil
+}
@@ -0,0 +1,74 @@
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var iidICoreWebView2_3 = windows.GUID{Data1: 0xA0D6DF20, Data2: 0x3B92, Data3: 0x416D, Data4: [8]byte{0xAA, 0x0C, 0x43, 0x7A, 0x9C, 0x72, 0x78, 0x57}}
+
+type iCoreWebView2_3Vtbl struct {
+	iCoreWebView2_2Vtbl
+	TrySuspend                          ComProc
+	Resume                              ComProc
+	GetIsSuspended                      ComProc
+	SetVirtualHostNameToFolderMapping   ComProc
+	ClearVirtualHostNameToFolderMapping ComProc
+}
+
+type ICoreWebView2_3 struct {
+	vtbl *iCoreWebView2_3Vtbl
+}
+
+// GetICoreWebView2_3 returns the ICoreWebView2_3 interface of the webview, or
+// nil if the installed runtime does not implement it. The caller must Release
+// the result.
+func (i *ICoreWebView2) GetICoreWebView2_3() *ICoreWebView2_3 {
+	var result *ICoreWebView2_3
+	if !queryInterface(unsafe.Pointer(i), &iidICoreWebView2_3, unsafe.Pointer(&result)) {
+		return nil
+	}
+	return result
+}
+
+func (i *ICoreWebView2_3) Release() {
+	release(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2_3) SetVirtualHostNameToFolderMapping(hostName, folderPath string, accessKind COREWEBVIEW2_HOST_RESOURCE_ACCESS_KIND) error {
+	_hostName, err := windows.UTF16PtrFromString(hostName)
+	if err != nil {
+		return err
+	}
+	_folderPath, err := windows.UTF16PtrFromString(folderPath)
+	if err != nil {
+		return err
+	}
+	hr, _, _ := i.vtbl.SetVirtualHostNameToFolderMapping.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_hostName)),
+		uintptr(unsafe.Pointer(_folderPath)),
+		uintptr(accessKind),
+	)
+	if int32(hr) < 0 {
+		return syscall.Errno(hr)
+	}
+	return nil
+}
+
+func (i *ICoreWebView2_3) ClearVirtualHostNameToFolderMapping(hostName string) error {
+	_hostName, err := windows.UTF16PtrFromString(hostName)
+	if err != nil {
+		return err
+	}
+	hr, _, _ := i.vtbl.ClearVirtualHostNameToFolderMapping.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_hostName)),
+	)
+	if int32(hr) < 0 {
+		return syscall.Errno(hr)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var iidICoreWebView2_4 = windows.GUID{Data1: 0x20D02D59, Data2: 0x6DF2, Data3: 0x42DC, Data4: [8]byte{0xBD, 0x06, 0xF9, 0x8A, 0x69, 0x4B, 0x13, 0x02}}
+
+type iCoreWebView2_4Vtbl struct {
+	iCoreWebView2_3Vtbl
+	AddFrameCreated        ComProc
+	RemoveFrameCreated     ComProc
+	AddDownloadStarting    ComProc
+	RemoveDownloadStarting ComProc
+}
+
+type ICoreWebView2_4 struct {
+	vtbl *iCoreWebView2_4Vtbl
+}
+
+// GetICoreWebView2_4 returns the ICoreWebView2_4 interface of the webview, or
+// nil if the installed runtime does not implement it. The caller must Release
+// the result.
+func (i *ICoreWebView2) GetICoreWebView2_4() *ICoreWebView2_4 {
+	var result *ICoreWebView2_4
+	if !queryInterface(unsafe.Pointer(i), &iidICoreWebView2_4, unsafe.Pointer(&result)) {
+		return nil
+	}
+	return result
+}
+
+func (i *ICoreWebView2_4) Release() {
+	release(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2_4) AddDownloadStarting(eventHandler *ICoreWebView2DownloadStartingEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddDownloadStarting.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
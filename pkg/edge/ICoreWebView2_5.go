@@ -0,0 +1,34 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var iidICoreWebView2_5 = windows.GUID{Data1: 0xBEDB11B8, Data2: 0xD63C, Data3: 0x11EB, Data4: [8]byte{0xB8, 0xBC, 0x02, 0x42, 0xAC, 0x13, 0x00, 0x03}}
+
+type iCoreWebView2_5Vtbl struct {
+	iCoreWebView2_4Vtbl
+	AddClientCertificateRequested    ComProc
+	RemoveClientCertificateRequested ComProc
+}
+
+type ICoreWebView2_5 struct {
+	vtbl *iCoreWebView2_5Vtbl
+}
+
+// GetICoreWebView2_5 returns the ICoreWebView2_5 interface of the webview, or
+// nil if the installed runtime does not implement it. The caller must Release
+// the result.
+func (i *ICoreWebView2) GetICoreWebView2_5() *ICoreWebView2_5 {
+	var result *ICoreWebView2_5
+	if !queryInterface(unsafe.Pointer(i), &iidICoreWebView2_5, unsafe.Pointer(&result)) {
+		return nil
+	}
+	return result
+}
+
+func (i *ICoreWebView2_5) Release() {
+	release(unsafe.Pointer(i))
+}
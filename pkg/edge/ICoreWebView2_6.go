@@ -0,0 +1,33 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var iidICoreWebView2_6 = windows.GUID{Data1: 0x499AADAC, Data2: 0xD92C, Data3: 0x4589, Data4: [8]byte{0x8A, 0x75, 0x11, 0x1B, 0xFC, 0x16, 0x77, 0x95}}
+
+type iCoreWebView2_6Vtbl struct {
+	iCoreWebView2_5Vtbl
+	OpenTaskManagerWindow ComProc
+}
+
+type ICoreWebView2_6 struct {
+	vtbl *iCoreWebView2_6Vtbl
+}
+
+// GetICoreWebView2_6 returns the ICoreWebView2_6 interface of the webview, or
+// nil if the installed runtime does not implement it. The caller must Release
+// the result.
+func (i *ICoreWebView2) GetICoreWebView2_6() *ICoreWebView2_6 {
+	var result *ICoreWebView2_6
+	if !queryInterface(unsafe.Pointer(i), &iidICoreWebView2_6, unsafe.Pointer(&result)) {
+		return nil
+	}
+	return result
+}
+
+func (i *ICoreWebView2_6) Release() {
+	release(unsafe.Pointer(i))
+}
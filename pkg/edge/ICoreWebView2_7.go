@@ -0,0 +1,62 @@
+package edge
+
+import (
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var iidICoreWebView2_7 = windows.GUID{Data1: 0x79C24D83, Data2: 0x09A3, Data3: 0x45AE, Data4: [8]byte{0x94, 0x18, 0x48, 0x7F, 0x32, 0xA5, 0x87, 0x40}}
+
+type iCoreWebView2_7Vtbl struct {
+	iCoreWebView2_6Vtbl
+	PrintToPdf ComProc
+}
+
+type ICoreWebView2_7 struct {
+	vtbl *iCoreWebView2_7Vtbl
+}
+
+// GetICoreWebView2_7 returns the ICoreWebView2_7 interface of the webview, or
+// nil if the installed runtime does not implement it. The caller must Release
+// the result.
+func (i *ICoreWebView2) GetICoreWebView2_7() *ICoreWebView2_7 {
+	var result *ICoreWebView2_7
+	if !queryInterface(unsafe.Pointer(i), &iidICoreWebView2_7, unsafe.Pointer(&result)) {
+		return nil
+	}
+	return result
+}
+
+func (i *ICoreWebView2_7) Release() {
+	release(unsafe.Pointer(i))
+}
+
+// PrintToPdf saves the page as a PDF file at resultFilePath and calls
+// completed on the UI thread once it is done. printSettings may be nil for
+// the defaults.
+func (i *ICoreWebView2_7) PrintToPdf(resultFilePath string, printSettings *ICoreWebView2PrintSettings, completed func(isSuccessful bool, err error)) error {
+	_resultFilePath, err := windows.UTF16PtrFromString(resultFilePath)
+	if err != nil {
+		return err
+	}
+	handler := newICoreWebView2PrintToPdfCompletedHandler(func(errorCode uintptr, isSuccessful bool) {
+		if int32(errorCode) < 0 {
+			completed(false, syscall.Errno(errorCode))
+			return
+		}
+		completed(isSuccessful, nil)
+	})
+	hr, _, _ := i.vtbl.PrintToPdf.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_resultFilePath)),
+		uintptr(unsafe.Pointer(printSettings)),
+		uintptr(unsafe.Pointer(handler)),
+	)
+	if int32(hr) < 0 {
+		unpin(unsafe.Pointer(handler))
+		return syscall.Errno(hr)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var iidICoreWebView2_8 = windows.GUID{Data1: 0xE9632730, Data2: 0x6E1E, Data3: 0x43AB, Data4: [8]byte{0xB7, 0xB8, 0x7B, 0x2C, 0x9E, 0x62, 0xE0, 0x94}}
+
+type iCoreWebView2_8Vtbl struct {
+	iCoreWebView2_7Vtbl
+	AddIsMutedChanged                   ComProc
+	RemoveIsMutedChanged                ComProc
+	GetIsMuted                          ComProc
+	PutIsMuted                          ComProc
+	AddIsDocumentPlayingAudioChanged    ComProc
+	RemoveIsDocumentPlayingAudioChanged ComProc
+	GetIsDocumentPlayingAudio           ComProc
+}
+
+type ICoreWebView2_8 struct {
+	vtbl *iCoreWebView2_8Vtbl
+}
+
+// GetICoreWebView2_8 returns the ICoreWebView2_8 interface of the webview, or
+// nil if the installed runtime does not implement it. The caller must Release
+// the result.
+func (i *ICoreWebView2) GetICoreWebView2_8() *ICoreWebView2_8 {
+	var result *ICoreWebView2_8
+	if !queryInterface(unsafe.Pointer(i), &iidICoreWebView2_8, unsafe.Pointer(&result)) {
+		return nil
+	}
+	return result
+}
+
+func (i *ICoreWebView2_8) Release() {
+	release(unsafe.Pointer(i))
+}
+
+func (i *ICoreWebView2_8) AddIsMutedChanged(eventHandler *ICoreWebView2IsMutedChangedEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddIsMutedChanged.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2_8) AddIsDocumentPlayingAudioChanged(eventHandler *ICoreWebView2IsDocumentPlayingAudioChangedEventHandler, token *_EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.AddIsDocumentPlayingAudioChanged.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(eventHandler)),
+		uintptr(unsafe.Pointer(token)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2_8) getBool(proc ComProc) (bool, error) {
+	var err error
+	var value int32
+	_, _, err = proc.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	return value != 0, nil
+}
+
+func (i *ICoreWebView2_8) GetIsMuted() (bool, error) {
+	return i.getBool(i.vtbl.GetIsMuted)
+}
+
+func (i *ICoreWebView2_8) PutIsMuted(value bool) error {
+	var err error
+	_, _, err = i.vtbl.PutIsMuted.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(boolToInt(value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2_8) GetIsDocumentPlayingAudio() (bool, error) {
+	return i.getBool(i.vtbl.GetIsDocumentPlayingAudio)
+}
@@ -0,0 +1,41 @@
+package edge
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var iidICoreWebView2_9 = windows.GUID{Data1: 0x4D7B2EAB, Data2: 0x9FDC, Data3: 0x468D, Data4: [8]byte{0xB9, 0x98, 0xA9, 0x26, 0x0B, 0x5E, 0xD6, 0x51}}
+
+type iCoreWebView2_9Vtbl struct {
+	iCoreWebView2_8Vtbl
+	AddIsDefaultDownloadDialogOpenChanged    ComProc
+	RemoveIsDefaultDownloadDialogOpenChanged ComProc
+	GetIsDefaultDownloadDialogOpen           ComProc
+	OpenDefaultDownloadDialog                ComProc
+	CloseDefaultDownloadDialog               ComProc
+	GetDefaultDownloadDialogCornerAlignment  ComProc
+	PutDefaultDownloadDialogCornerAlignment  ComProc
+	GetDefaultDownloadDialogMargin           ComProc
+	PutDefaultDownloadDialogMargin           ComProc
+}
+
+type ICoreWebView2_9 struct {
+	vtbl *iCoreWebView2_9Vtbl
+}
+
+// GetICoreWebView2_9 returns the ICoreWebView2_9 interface of the webview, or
+// nil if the installed runtime does not implement it. The caller must Release
+// the result.
+func (i *ICoreWebView2) GetICoreWebView2_9() *ICoreWebView2_9 {
+	var result *ICoreWebView2_9
+	if !queryInterface(unsafe.Pointer(i), &iidICoreWebView2_9, unsafe.Pointer(&result)) {
+		return nil
+	}
+	return result
+}
+
+func (i *ICoreWebView2_9) Release() {
+	release(unsafe.Pointer(i))
+}
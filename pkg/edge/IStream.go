@@ -0,0 +1,88 @@
+package edge
+
+import (
+	"io"
+	"unsafe"
+
+	"github.com/project-vrcat/go-webview2/internal/w32"
+	"golang.org/x/sys/windows"
+)
+
+type _IStreamVtbl struct {
+	_IUnknownVtbl
+	Read         ComProc
+	Write        ComProc
+	Seek         ComProc
+	SetSize      ComProc
+	CopyTo       ComProc
+	Commit       ComProc
+	Revert       ComProc
+	LockRegion   ComProc
+	UnlockRegion ComProc
+	Stat         ComProc
+	Clone        ComProc
+}
+
+// IStream is the COM stream WebView2 uses for request and response bodies.
+// Its Seek takes the io.Seek constants, which match the STREAM_SEEK values of
+// COM.
+type IStream struct {
+	vtbl *_IStreamVtbl
+}
+
+// NewMemoryStream creates an IStream in memory that holds a copy of data.
+// The caller must Release it.
+func NewMemoryStream(data []byte) (*IStream, error) {
+	stream, err := w32.SHCreateMemStream(data)
+	if err != nil {
+		return nil, err
+	}
+	return (*IStream)(unsafe.Pointer(stream)), nil
+}
+
+func (i *IStream) Release() {
+	release(unsafe.Pointer(i))
+}
+
+// Read reads up to len(p) bytes from the stream. It returns 0 at the end of
+// the stream.
+func (i *IStream) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	var read uint32
+	hr, _, _ := i.vtbl.Read.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&p[0])),
+		uintptr(len(p)),
+		uintptr(unsafe.Pointer(&read)),
+	)
+	if int32(hr) < 0 {
+		return int(read), windows.Errno(hr)
+	}
+	return int(read), nil
+}
+
+// ReadAll reads the stream until its end.
+func (i *IStream) ReadAll() ([]byte, error) {
+	var data []byte
+	buf := make([]byte, 4096)
+	for {
+		n, err := i.Read(buf)
+		data = append(data, buf[:n]...)
+		if err != nil {
+			return data, err
+		}
+		if n == 0 {
+			return data, nil
+		}
+	}
+}
+
+// Bytes returns the whole content of the stream, from its start.
+func (i *IStream) Bytes() ([]byte, error) {
+	if _, err := i.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return i.ReadAll()
+}
@@ -4,10 +4,14 @@
 package edge
 
 import (
+	"errors"
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 	"sync/atomic"
+	"syscall"
 	"unsafe"
 
 	"github.com/project-vrcat/go-webview2/internal/w32"
@@ -15,32 +19,257 @@ import (
 )
 
 type Chromium struct {
-	hwnd                  uintptr
-	controller            *iCoreWebView2Controller
-	webview               *ICoreWebView2
-	inited                uintptr
-	envCompleted          *iCoreWebView2CreateCoreWebView2EnvironmentCompletedHandler
-	controllerCompleted   *iCoreWebView2CreateCoreWebView2ControllerCompletedHandler
-	webMessageReceived    *iCoreWebView2WebMessageReceivedEventHandler
-	permissionRequested   *iCoreWebView2PermissionRequestedEventHandler
-	webResourceRequested  *iCoreWebView2WebResourceRequestedEventHandler
-	acceleratorKeyPressed *ICoreWebView2AcceleratorKeyPressedEventHandler
-	navigationCompleted   *ICoreWebView2NavigationCompletedEventHandler
-
-	environment *ICoreWebView2Environment
+	hwnd                             uintptr
+	controller                       *iCoreWebView2Controller
+	webview                          *ICoreWebView2
+	inited                           uintptr
+	envCompleted                     *iCoreWebView2CreateCoreWebView2EnvironmentCompletedHandler
+	controllerCompleted              *iCoreWebView2CreateCoreWebView2ControllerCompletedHandler
+	webMessageReceived               *iCoreWebView2WebMessageReceivedEventHandler
+	permissionRequested              *iCoreWebView2PermissionRequestedEventHandler
+	webResourceRequested             *iCoreWebView2WebResourceRequestedEventHandler
+	acceleratorKeyPressed            *ICoreWebView2AcceleratorKeyPressedEventHandler
+	navigationCompleted              *ICoreWebView2NavigationCompletedEventHandler
+	navigationStarting               *ICoreWebView2NavigationStartingEventHandler
+	documentTitleChanged             *ICoreWebView2DocumentTitleChangedEventHandler
+	historyChanged                   *ICoreWebView2HistoryChangedEventHandler
+	zoomFactorChanged                *ICoreWebView2ZoomFactorChangedEventHandler
+	windowCloseRequested             *ICoreWebView2WindowCloseRequestedEventHandler
+	newWindowRequested               *ICoreWebView2NewWindowRequestedEventHandler
+	scriptDialogOpening              *ICoreWebView2ScriptDialogOpeningEventHandler
+	contextMenuRequested             *ICoreWebView2ContextMenuRequestedEventHandler
+	customItemSelected               *ICoreWebView2CustomItemSelectedEventHandler
+	containsFullScreenElementChanged *ICoreWebView2ContainsFullScreenElementChangedEventHandler
+	processFailed                    *ICoreWebView2ProcessFailedEventHandler
+	webResourceResponseReceived      *ICoreWebView2WebResourceResponseReceivedEventHandler
+	downloadStarting                 *ICoreWebView2DownloadStartingEventHandler
+	downloadStateChanged             *ICoreWebView2StateChangedEventHandler
+	isMutedChanged                   *ICoreWebView2IsMutedChangedEventHandler
+	isDocumentPlayingAudioChanged    *ICoreWebView2IsDocumentPlayingAudioChangedEventHandler
+	frameNavigationStarting          *ICoreWebView2FrameNavigationStartingEventHandler
+	basicAuthenticationRequested     *ICoreWebView2BasicAuthenticationRequestedEventHandler
+	serverCertificateErrorDetected   *ICoreWebView2ServerCertificateErrorDetectedEventHandler
+
+	environment   *ICoreWebView2Environment
+	options       EnvironmentOptions
+	envOptions    *iCoreWebView2EnvironmentOptions
+	shared        *SharedEnvironment
+	cookieManager *CookieManager
 
 	// Settings
 	Debug bool
 
+	// InPrivate creates the webview in an InPrivate profile, which keeps no
+	// cookies, history or cache once it is closed
+	InPrivate bool
+
 	// Callbacks
-	MessageCallback              func(string)
-	WebResourceRequestedCallback func(request *ICoreWebView2WebResourceRequest, args *ICoreWebView2WebResourceRequestedEventArgs)
-	NavigationCompletedCallback  func(sender *ICoreWebView2, args *ICoreWebView2NavigationCompletedEventArgs)
-	AcceleratorKeyCallback       func(uint)
+	MessageCallback                          func(string)
+	WebMessageReceivedCallback               func(source, message string)
+	WebResourceRequestedCallback             func(request *ICoreWebView2WebResourceRequest, args *ICoreWebView2WebResourceRequestedEventArgs)
+	NavigationCompletedCallback              func(sender *ICoreWebView2, args *ICoreWebView2NavigationCompletedEventArgs)
+	NavigationStartingCallback               func(sender *ICoreWebView2, args *ICoreWebView2NavigationStartingEventArgs)
+	DocumentTitleChangedCallback             func(sender *ICoreWebView2)
+	HistoryChangedCallback                   func(sender *ICoreWebView2)
+	ZoomFactorChangedCallback                func(zoomFactor float64)
+	AcceleratorKeyCallback                   func(uint)
+	WindowCloseRequestedCallback             func(sender *ICoreWebView2)
+	NewWindowRequestedCallback               func(sender *ICoreWebView2, args *ICoreWebView2NewWindowRequestedEventArgs)
+	PermissionRequestedCallback              func(sender *ICoreWebView2, args *ICoreWebView2PermissionRequestedEventArgs)
+	ScriptDialogOpeningCallback              func(sender *ICoreWebView2, args *ICoreWebView2ScriptDialogOpeningEventArgs)
+	ContextMenuRequestedCallback             func(sender *ICoreWebView2, args *ICoreWebView2ContextMenuRequestedEventArgs)
+	CustomItemSelectedCallback               func(item *ICoreWebView2ContextMenuItem)
+	ContainsFullScreenElementChangedCallback func(sender *ICoreWebView2)
+	ProcessFailedCallback                    func(sender *ICoreWebView2, args *ICoreWebView2ProcessFailedEventArgs)
+	WebResourceResponseReceivedCallback      func(sender *ICoreWebView2, args *ICoreWebView2WebResourceResponseReceivedEventArgs)
+	DownloadStartingCallback                 func(sender *ICoreWebView2, args *ICoreWebView2DownloadStartingEventArgs)
+	DownloadStateChangedCallback             func(download *ICoreWebView2DownloadOperation)
+	IsMutedChangedCallback                   func(sender *ICoreWebView2)
+	IsDocumentPlayingAudioChangedCallback    func(sender *ICoreWebView2)
+	FrameNavigationStartingCallback          func(sender *ICoreWebView2, args *ICoreWebView2NavigationStartingEventArgs)
+	BasicAuthenticationRequestedCallback     func(sender *ICoreWebView2, args *ICoreWebView2BasicAuthenticationRequestedEventArgs)
+	ServerCertificateErrorDetectedCallback   func(sender *ICoreWebView2, args *ICoreWebView2ServerCertificateErrorDetectedEventArgs)
+}
+
+// EnvironmentOptions configures the browser environment a Chromium creates
+// in Embed.
+type EnvironmentOptions struct {
+	// AdditionalBrowserArguments are passed to the browser process, for
+	// example "--disable-features=msSmartScreenProtection"
+	AdditionalBrowserArguments []string
+
+	// BrowserExecutableFolder runs a fixed version runtime from this folder
+	// instead of the installed one
+	BrowserExecutableFolder string
+
+	// UserDataFolder is used when Embed is not given a user data folder
+	UserDataFolder string
+
+	// Language is the default display language, such as "en-US"
+	Language string
+
+	// EnableTrackingPrevention turns the tracking prevention of the browser
+	// on or off. Nil keeps the default of WebView2, which turns it on
+	EnableTrackingPrevention *bool
+
+	// DisableHardwareAcceleration renders and composites pages in software,
+	// without the GPU. This is much slower, but works in virtual machines,
+	// remote desktop sessions and with GPU drivers that crash WebView2
+	DisableHardwareAcceleration bool
+
+	// CustomSchemeRegistrations are the custom URI schemes pages may use
+	CustomSchemeRegistrations []CustomSchemeRegistration
+
+	// Proxy is the proxy the browser uses; see SetProxy
+	Proxy ProxyConfig
+}
+
+// ProxyType selects how the browser connects to the network.
+type ProxyType int
+
+const (
+	// ProxyTypeSystem uses the proxy settings of Windows
+	ProxyTypeSystem ProxyType = iota
+
+	// ProxyTypeDirect connects without a proxy
+	ProxyTypeDirect
+
+	// ProxyTypeAutoDetect finds a proxy through WPAD
+	ProxyTypeAutoDetect
+
+	// ProxyTypeFixedServers uses the proxies given in Server
+	ProxyTypeFixedServers
+
+	// ProxyTypePAC uses the proxy auto-config script at PACScriptURL
+	ProxyTypePAC
+)
+
+// ProxyConfig configures the proxy of the browser.
+type ProxyConfig struct {
+	Type ProxyType
+
+	// Server is the proxy for ProxyTypeFixedServers, such as
+	// "http://proxy:8080" or "http=proxy1:80;https=proxy2:443"
+	Server string
+
+	// BypassList lists the hosts that are reached without the proxy of
+	// ProxyTypeFixedServers, separated by semicolons, such as
+	// "localhost;*.corp.example.com"
+	BypassList string
+
+	// PACScriptURL is the URL of the script for ProxyTypePAC
+	PACScriptURL string
+}
+
+// SetProxy sets the proxy of the browser. The proxy is passed as browser
+// arguments, so it only applies to environments created after it is set; a
+// running environment keeps its proxy.
+func (o *EnvironmentOptions) SetProxy(config ProxyConfig) error {
+	switch config.Type {
+	case ProxyTypeSystem, ProxyTypeDirect, ProxyTypeAutoDetect:
+	case ProxyTypeFixedServers:
+		if config.Server == "" {
+			return errors.New("proxy server not set")
+		}
+	case ProxyTypePAC:
+		if config.PACScriptURL == "" {
+			return errors.New("proxy PAC script URL not set")
+		}
+	default:
+		return errors.New("unknown proxy type")
+	}
+	o.Proxy = config
+	return nil
+}
+
+// proxyArguments returns the browser arguments that select the proxy.
+func (c ProxyConfig) proxyArguments() []string {
+	switch c.Type {
+	case ProxyTypeDirect:
+		return []string{"--no-proxy-server"}
+	case ProxyTypeAutoDetect:
+		return []string{"--proxy-auto-detect"}
+	case ProxyTypeFixedServers:
+		args := []string{"--proxy-server=" + c.Server}
+		if c.BypassList != "" {
+			args = append(args, "--proxy-bypass-list="+c.BypassList)
+		}
+		return args
+	case ProxyTypePAC:
+		return []string{"--proxy-pac-url=" + c.PACScriptURL}
+	}
+	return nil
+}
+
+// AutoplayPolicy decides whether pages may play media with sound before the
+// user interacts with them.
+type AutoplayPolicy int
+
+const (
+	// AutoplayPolicyUserGestureRequired only plays media once the user has
+	// interacted with the frame
+	AutoplayPolicyUserGestureRequired AutoplayPolicy = iota
+
+	// AutoplayPolicyNoUserGestureRequired lets pages play media right away
+	AutoplayPolicyNoUserGestureRequired
+
+	// AutoplayPolicyDocumentUserActivationRequired plays media once the user
+	// has interacted with the document or one of its frames
+	AutoplayPolicyDocumentUserActivationRequired
+)
+
+// SetMediaAutoplay sets the autoplay policy for media by adding an
+// --autoplay-policy argument to AdditionalBrowserArguments, in place of any
+// given before. WebView2 has no setting for it, so like SetProxy it only
+// applies to environments created after it is set.
+func (o *EnvironmentOptions) SetMediaAutoplay(policy AutoplayPolicy) {
+	var value string
+	switch policy {
+	case AutoplayPolicyNoUserGestureRequired:
+		value = "no-user-gesture-required"
+	case AutoplayPolicyDocumentUserActivationRequired:
+		value = "document-user-activation-required"
+	default:
+		value = "user-gesture-required"
+	}
+	var args []string
+	for _, arg := range o.AdditionalBrowserArguments {
+		if !strings.HasPrefix(arg, "--autoplay-policy=") {
+			args = append(args, arg)
+		}
+	}
+	o.AdditionalBrowserArguments = append(args, "--autoplay-policy="+value)
+}
+
+func (o *EnvironmentOptions) isZero() bool {
+	return len(o.AdditionalBrowserArguments) == 0 &&
+		o.BrowserExecutableFolder == "" &&
+		o.UserDataFolder == "" &&
+		o.Language == "" &&
+		o.EnableTrackingPrevention == nil &&
+		!o.DisableHardwareAcceleration &&
+		len(o.CustomSchemeRegistrations) == 0 &&
+		o.Proxy.Type == ProxyTypeSystem
 }
 
 func NewChromium() *Chromium {
+	return NewChromiumWithOptions(EnvironmentOptions{})
+}
+
+// NewChromiumWithEnvironment creates a Chromium that uses env instead of
+// creating its own environment. The user data folder passed to Embed is
+// ignored then.
+func NewChromiumWithEnvironment(env *SharedEnvironment) *Chromium {
+	e := NewChromium()
+	e.shared = env
+	return e
+}
+
+// NewChromiumWithOptions creates a Chromium whose browser environment is set
+// up with opts.
+func NewChromiumWithOptions(opts EnvironmentOptions) *Chromium {
 	e := &Chromium{}
+	e.options = opts
 	e.envCompleted = newICoreWebView2CreateCoreWebView2EnvironmentCompletedHandler(e)
 	e.controllerCompleted = newICoreWebView2CreateCoreWebView2ControllerCompletedHandler(e)
 	e.webMessageReceived = newICoreWebView2WebMessageReceivedEventHandler(e)
@@ -48,41 +277,100 @@ func NewChromium() *Chromium {
 	e.webResourceRequested = newICoreWebView2WebResourceRequestedEventHandler(e)
 	e.acceleratorKeyPressed = newICoreWebView2AcceleratorKeyPressedEventHandler(e)
 	e.navigationCompleted = newICoreWebView2NavigationCompletedEventHandler(e)
+	e.navigationStarting = newICoreWebView2NavigationStartingEventHandler(e)
+	e.documentTitleChanged = newICoreWebView2DocumentTitleChangedEventHandler(e)
+	e.historyChanged = newICoreWebView2HistoryChangedEventHandler(e)
+	e.zoomFactorChanged = newICoreWebView2ZoomFactorChangedEventHandler(e)
+	e.windowCloseRequested = newICoreWebView2WindowCloseRequestedEventHandler(e)
+	e.newWindowRequested = newICoreWebView2NewWindowRequestedEventHandler(e)
+	e.scriptDialogOpening = newICoreWebView2ScriptDialogOpeningEventHandler(e)
+	e.contextMenuRequested = newICoreWebView2ContextMenuRequestedEventHandler(e)
+	e.customItemSelected = newICoreWebView2CustomItemSelectedEventHandler(e)
+	e.containsFullScreenElementChanged = newICoreWebView2ContainsFullScreenElementChangedEventHandler(e)
+	e.processFailed = newICoreWebView2ProcessFailedEventHandler(e)
+	e.webResourceResponseReceived = newICoreWebView2WebResourceResponseReceivedEventHandler(e)
+	e.downloadStarting = newICoreWebView2DownloadStartingEventHandler(e)
+	e.downloadStateChanged = newICoreWebView2StateChangedEventHandler(e)
+	e.isMutedChanged = newICoreWebView2IsMutedChangedEventHandler(e)
+	e.isDocumentPlayingAudioChanged = newICoreWebView2IsDocumentPlayingAudioChangedEventHandler(e)
+	e.frameNavigationStarting = newICoreWebView2FrameNavigationStartingEventHandler(e)
+	e.basicAuthenticationRequested = newICoreWebView2BasicAuthenticationRequestedEventHandler(e)
+	e.serverCertificateErrorDetected = newICoreWebView2ServerCertificateErrorDetectedEventHandler(e)
 
 	return e
 }
 
 func (e *Chromium) Embed(hwnd uintptr, userDataFolder ...string) bool {
 	e.hwnd = hwnd
-	var dataPath string
-	if len(userDataFolder) > 0 {
-		var err error
-		dataPath, err = filepath.Abs(userDataFolder[0])
+	if e.shared != nil {
+		e.EnvironmentCompleted(0, e.shared.environment)
+	} else {
+		folder := e.options.UserDataFolder
+		if len(userDataFolder) > 0 {
+			folder = userDataFolder[0]
+		}
+		dataPath, err := userDataPath(folder)
 		if err != nil {
 			log.Printf("Error UserData Folder: %v", err)
 			return false
 		}
-	} else {
-		currentExePath := make([]uint16, windows.MAX_PATH)
-		_, err := windows.GetModuleFileName(windows.Handle(0), &currentExePath[0], windows.MAX_PATH)
+		e.envOptions, err = createEnvironment(e.options, dataPath, e.envCompleted)
 		if err != nil {
-			// What to do here?
+			log.Printf("Error calling Webview2Loader: %v", err)
 			return false
 		}
-		currentExeName := filepath.Base(windows.UTF16ToString(currentExePath))
-		dataPath = filepath.Join(os.Getenv("AppData"), currentExeName)
 	}
-	res, err := createCoreWebView2EnvironmentWithOptions(nil, windows.StringToUTF16Ptr(dataPath), 0, e.envCompleted)
+	pumpUntil(&e.inited)
+	e.Init("window.external={invoke:s=>window.chrome.webview.postMessage(s)}")
+	return true
+}
+
+// userDataPath returns the absolute path of folder, or of a folder named after
+// the executable in %AppData% if folder is empty.
+func userDataPath(folder string) (string, error) {
+	if folder != "" {
+		return filepath.Abs(folder)
+	}
+	currentExePath := make([]uint16, windows.MAX_PATH)
+	_, err := windows.GetModuleFileName(windows.Handle(0), &currentExePath[0], windows.MAX_PATH)
 	if err != nil {
-		log.Printf("Error calling Webview2Loader: %v", err)
-		return false
+		return "", err
+	}
+	currentExeName := filepath.Base(windows.UTF16ToString(currentExePath))
+	return filepath.Join(os.Getenv("AppData"), currentExeName), nil
+}
+
+// createEnvironment starts creating an environment set up with opts and
+// calls handler once it is ready. The returned options object must be kept
+// alive until then.
+func createEnvironment(opts EnvironmentOptions, dataPath string, handler *iCoreWebView2CreateCoreWebView2EnvironmentCompletedHandler) (*iCoreWebView2EnvironmentOptions, error) {
+	var browserExecutableFolder *uint16
+	if opts.BrowserExecutableFolder != "" {
+		browserExecutableFolder = windows.StringToUTF16Ptr(opts.BrowserExecutableFolder)
+	}
+	var envOptions *iCoreWebView2EnvironmentOptions
+	var environmentOptions uintptr
+	if !opts.isZero() {
+		envOptions = newICoreWebView2EnvironmentOptions(opts)
+		environmentOptions = uintptr(unsafe.Pointer(envOptions))
+	}
+	res, err := createCoreWebView2EnvironmentWithOptions(browserExecutableFolder, windows.StringToUTF16Ptr(dataPath), environmentOptions, handler)
+	if err != nil {
+		return nil, err
 	} else if res != 0 {
-		log.Printf("Result: %08x", res)
-		return false
+		return nil, syscall.Errno(res)
 	}
+	return envOptions, nil
+}
+
+// pumpUntil dispatches window messages until flag is set or WM_QUIT arrives.
+// Thread messages have no window to go to, so they are posted again once
+// flag is set for the message loop of the caller.
+func pumpUntil(flag *uintptr) {
 	var msg w32.Msg
+	var held []w32.Msg
 	for {
-		if atomic.LoadUintptr(&e.inited) != 0 {
+		if atomic.LoadUintptr(flag) != 0 {
 			break
 		}
 		r, _, _ := w32.User32GetMessageW.Call(
@@ -92,13 +380,22 @@ func (e *Chromium) Embed(hwnd uintptr, userDataFolder ...string) bool {
 			0,
 		)
 		if r == 0 {
+			w32.User32PostQuitMessage.Call(msg.WParam)
 			break
 		}
+		if msg.Hwnd == 0 {
+			held = append(held, msg)
+			continue
+		}
 		w32.User32TranslateMessage.Call(uintptr(unsafe.Pointer(&msg)))
 		w32.User32DispatchMessageW.Call(uintptr(unsafe.Pointer(&msg)))
 	}
-	e.Init("window.external={invoke:s=>window.chrome.webview.postMessage(s)}")
-	return true
+	if len(held) > 0 {
+		thread, _, _ := w32.Kernel32GetCurrentThreadID.Call()
+		for _, m := range held {
+			w32.User32PostThreadMessageW.Call(thread, uintptr(m.Message), m.WParam, m.LParam)
+		}
+	}
 }
 
 func (e *Chromium) Navigate(url string) {
@@ -108,6 +405,68 @@ func (e *Chromium) Navigate(url string) {
 	)
 }
 
+// NavigateToString loads html as the content of the top-level document. The
+// content is limited to 2 MB by WebView2.
+func (e *Chromium) NavigateToString(html string) error {
+	_html, err := windows.UTF16PtrFromString(html)
+	if err != nil {
+		return err
+	}
+	hr, _, _ := e.webview.vtbl.NavigateToString.Call(
+		uintptr(unsafe.Pointer(e.webview)),
+		uintptr(unsafe.Pointer(_html)),
+	)
+	if int32(hr) < 0 {
+		return syscall.Errno(hr)
+	}
+	return nil
+}
+
+// NavigateWithWebResourceRequest navigates to uri using the given HTTP
+// method, request body and headers. headers holds "Name: value" lines
+// separated by CRLF.
+func (e *Chromium) NavigateWithWebResourceRequest(uri, method string, content []byte, headers string) error {
+	webview2 := e.webview.GetICoreWebView2_2()
+	if webview2 == nil {
+		return ErrNotSupported
+	}
+	defer webview2.Release()
+	environment2 := e.environment.GetICoreWebView2Environment2()
+	if environment2 == nil {
+		return ErrNotSupported
+	}
+	defer environment2.Release()
+
+	request, err := environment2.CreateWebResourceRequest(uri, method, content, headers)
+	if err != nil {
+		return err
+	}
+	defer request.Release()
+	return webview2.NavigateWithWebResourceRequest(request)
+}
+
+// SetVirtualHostNameToFolderMapping serves the files in folderPath to pages
+// under https://hostName/.
+func (e *Chromium) SetVirtualHostNameToFolderMapping(hostName, folderPath string, accessKind COREWEBVIEW2_HOST_RESOURCE_ACCESS_KIND) error {
+	webview3 := e.webview.GetICoreWebView2_3()
+	if webview3 == nil {
+		return ErrNotSupported
+	}
+	defer webview3.Release()
+	return webview3.SetVirtualHostNameToFolderMapping(hostName, folderPath, accessKind)
+}
+
+// ClearVirtualHostNameToFolderMapping removes the mapping of hostName set
+// with SetVirtualHostNameToFolderMapping.
+func (e *Chromium) ClearVirtualHostNameToFolderMapping(hostName string) error {
+	webview3 := e.webview.GetICoreWebView2_3()
+	if webview3 == nil {
+		return ErrNotSupported
+	}
+	defer webview3.Release()
+	return webview3.ClearVirtualHostNameToFolderMapping(hostName)
+}
+
 func (e *Chromium) Init(script string) {
 	e.webview.vtbl.AddScriptToExecuteOnDocumentCreated.Call(
 		uintptr(unsafe.Pointer(e.webview)),
@@ -116,6 +475,51 @@ func (e *Chromium) Init(script string) {
 	)
 }
 
+// AddScriptToExecuteOnDocumentCreated is like Init, but calls completed on the
+// UI thread with the ID WebView2 assigned to the script, which can later be
+// passed to RemoveScriptToExecuteOnDocumentCreated.
+func (e *Chromium) AddScriptToExecuteOnDocumentCreated(script string, completed func(id string, err error)) error {
+	_script, err := windows.UTF16PtrFromString(script)
+	if err != nil {
+		return err
+	}
+
+	handler := newICoreWebView2AddScriptToExecuteOnDocumentCreatedCompletedHandler(func(errorCode uintptr, id string) {
+		if int32(errorCode) < 0 {
+			completed("", syscall.Errno(errorCode))
+			return
+		}
+		completed(id, nil)
+	})
+	hr, _, _ := e.webview.vtbl.AddScriptToExecuteOnDocumentCreated.Call(
+		uintptr(unsafe.Pointer(e.webview)),
+		uintptr(unsafe.Pointer(_script)),
+		uintptr(unsafe.Pointer(handler)),
+	)
+	if int32(hr) < 0 {
+		unpin(unsafe.Pointer(handler))
+		return syscall.Errno(hr)
+	}
+	return nil
+}
+
+// RemoveScriptToExecuteOnDocumentCreated stops injecting the script with the
+// given ID into new documents.
+func (e *Chromium) RemoveScriptToExecuteOnDocumentCreated(id string) error {
+	_id, err := windows.UTF16PtrFromString(id)
+	if err != nil {
+		return err
+	}
+	hr, _, _ := e.webview.vtbl.RemoveScriptToExecuteOnDocumentCreated.Call(
+		uintptr(unsafe.Pointer(e.webview)),
+		uintptr(unsafe.Pointer(_id)),
+	)
+	if int32(hr) < 0 {
+		return syscall.Errno(hr)
+	}
+	return nil
+}
+
 func (e *Chromium) Eval(script string) {
 
 	_script, err := windows.UTF16PtrFromString(script)
@@ -130,6 +534,34 @@ func (e *Chromium) Eval(script string) {
 	)
 }
 
+// ExecuteScript runs script in the top-level document and calls completed with
+// the JSON-encoded result of the last expression once it has finished.
+// completed is always called on the UI thread, unless an error is returned.
+func (e *Chromium) ExecuteScript(script string, completed func(result string, err error)) error {
+	_script, err := windows.UTF16PtrFromString(script)
+	if err != nil {
+		return err
+	}
+
+	handler := newICoreWebView2ExecuteScriptCompletedHandler(func(errorCode uintptr, result string) {
+		if int32(errorCode) < 0 {
+			completed("", syscall.Errno(errorCode))
+			return
+		}
+		completed(result, nil)
+	})
+	hr, _, _ := e.webview.vtbl.ExecuteScript.Call(
+		uintptr(unsafe.Pointer(e.webview)),
+		uintptr(unsafe.Pointer(_script)),
+		uintptr(unsafe.Pointer(handler)),
+	)
+	if int32(hr) < 0 {
+		unpin(unsafe.Pointer(handler))
+		return syscall.Errno(hr)
+	}
+	return nil
+}
+
 func (e *Chromium) Show() error {
 	return e.controller.PutIsVisible(true)
 }
@@ -138,6 +570,11 @@ func (e *Chromium) Hide() error {
 	return e.controller.PutIsVisible(false)
 }
 
+// MoveFocus gives the keyboard focus to the webview.
+func (e *Chromium) MoveFocus(reason COREWEBVIEW2_MOVE_FOCUS_REASON) error {
+	return e.controller.MoveFocus(reason)
+}
+
 func (e *Chromium) QueryInterface(_, _ uintptr) uintptr {
 	return 0
 }
@@ -155,6 +592,13 @@ func (e *Chromium) EnvironmentCompleted(res uintptr, env *ICoreWebView2Environme
 		log.Fatalf("Creating environment failed with %08x", res)
 	}
 	e.environment = env
+	if e.InPrivate {
+		err := e.createInPrivateController()
+		if err == nil {
+			return 0
+		}
+		log.Printf("Creating InPrivate controller failed: %v", err)
+	}
 	env.vtbl.CreateCoreWebView2Controller.Call(
 		uintptr(unsafe.Pointer(env)),
 		e.hwnd,
@@ -163,6 +607,23 @@ func (e *Chromium) EnvironmentCompleted(res uintptr, env *ICoreWebView2Environme
 	return 0
 }
 
+func (e *Chromium) createInPrivateController() error {
+	environment10 := e.environment.GetICoreWebView2Environment10()
+	if environment10 == nil {
+		return ErrNotSupported
+	}
+	defer environment10.Release()
+	options, err := environment10.CreateCoreWebView2ControllerOptions()
+	if err != nil {
+		return err
+	}
+	defer options.Release()
+	if err := options.PutIsInPrivateModeEnabled(true); err != nil {
+		return err
+	}
+	return environment10.CreateCoreWebView2ControllerWithOptions(e.hwnd, options, e.controllerCompleted)
+}
+
 func (e *Chromium) CreateCoreWebView2ControllerCompleted(res uintptr, controller *iCoreWebView2Controller) uintptr {
 	if int64(res) < 0 {
 		log.Fatalf("Creating controller failed with %08x", res)
@@ -198,8 +659,79 @@ func (e *Chromium) CreateCoreWebView2ControllerCompleted(res uintptr, controller
 		uintptr(unsafe.Pointer(e.navigationCompleted)),
 		uintptr(unsafe.Pointer(&token)),
 	)
+	e.webview.vtbl.AddNavigationStarting.Call(
+		uintptr(unsafe.Pointer(e.webview)),
+		uintptr(unsafe.Pointer(e.navigationStarting)),
+		uintptr(unsafe.Pointer(&token)),
+	)
+	e.webview.vtbl.AddDocumentTitleChanged.Call(
+		uintptr(unsafe.Pointer(e.webview)),
+		uintptr(unsafe.Pointer(e.documentTitleChanged)),
+		uintptr(unsafe.Pointer(&token)),
+	)
+	e.webview.vtbl.AddHistoryChanged.Call(
+		uintptr(unsafe.Pointer(e.webview)),
+		uintptr(unsafe.Pointer(e.historyChanged)),
+		uintptr(unsafe.Pointer(&token)),
+	)
+	e.webview.vtbl.AddWindowCloseRequested.Call(
+		uintptr(unsafe.Pointer(e.webview)),
+		uintptr(unsafe.Pointer(e.windowCloseRequested)),
+		uintptr(unsafe.Pointer(&token)),
+	)
+	e.webview.vtbl.AddNewWindowRequested.Call(
+		uintptr(unsafe.Pointer(e.webview)),
+		uintptr(unsafe.Pointer(e.newWindowRequested)),
+		uintptr(unsafe.Pointer(&token)),
+	)
+	e.webview.vtbl.AddScriptDialogOpening.Call(
+		uintptr(unsafe.Pointer(e.webview)),
+		uintptr(unsafe.Pointer(e.scriptDialogOpening)),
+		uintptr(unsafe.Pointer(&token)),
+	)
+	if webview2 := e.webview.GetICoreWebView2_2(); webview2 != nil {
+		webview2.AddWebResourceResponseReceived(e.webResourceResponseReceived, &token)
+		webview2.Release()
+	}
+	if webview4 := e.webview.GetICoreWebView2_4(); webview4 != nil {
+		webview4.AddDownloadStarting(e.downloadStarting, &token)
+		webview4.Release()
+	}
+	if webview8 := e.webview.GetICoreWebView2_8(); webview8 != nil {
+		webview8.AddIsMutedChanged(e.isMutedChanged, &token)
+		webview8.AddIsDocumentPlayingAudioChanged(e.isDocumentPlayingAudioChanged, &token)
+		webview8.Release()
+	}
+	if webview10 := e.webview.GetICoreWebView2_10(); webview10 != nil {
+		webview10.AddBasicAuthenticationRequested(e.basicAuthenticationRequested, &token)
+		webview10.Release()
+	}
+	if webview11 := e.webview.GetICoreWebView2_11(); webview11 != nil {
+		webview11.AddContextMenuRequested(e.contextMenuRequested, &token)
+		webview11.Release()
+	}
+	if webview14 := e.webview.GetICoreWebView2_14(); webview14 != nil {
+		webview14.AddServerCertificateErrorDetected(e.serverCertificateErrorDetected, &token)
+		webview14.Release()
+	}
+	e.webview.vtbl.AddContainsFullScreenElementChanged.Call(
+		uintptr(unsafe.Pointer(e.webview)),
+		uintptr(unsafe.Pointer(e.containsFullScreenElementChanged)),
+		uintptr(unsafe.Pointer(&token)),
+	)
+	e.webview.vtbl.AddProcessFailed.Call(
+		uintptr(unsafe.Pointer(e.webview)),
+		uintptr(unsafe.Pointer(e.processFailed)),
+		uintptr(unsafe.Pointer(&token)),
+	)
+	e.webview.vtbl.AddFrameNavigationStarting.Call(
+		uintptr(unsafe.Pointer(e.webview)),
+		uintptr(unsafe.Pointer(e.frameNavigationStarting)),
+		uintptr(unsafe.Pointer(&token)),
+	)
 
 	e.controller.AddAcceleratorKeyPressed(e.acceleratorKeyPressed, &token)
+	e.controller.AddZoomFactorChanged(e.zoomFactorChanged, &token)
 
 	atomic.StoreUintptr(&e.inited, 1)
 
@@ -215,6 +747,15 @@ func (e *Chromium) MessageReceived(sender *ICoreWebView2, args *iCoreWebView2Web
 	if e.MessageCallback != nil {
 		e.MessageCallback(w32.Utf16PtrToString(message))
 	}
+	if e.WebMessageReceivedCallback != nil {
+		source, _ := args.GetSource()
+		// Messages that are not strings are passed on as JSON.
+		if message != nil {
+			e.WebMessageReceivedCallback(source, w32.Utf16PtrToString(message))
+		} else if json, err := args.GetWebMessageAsJSON(); err == nil {
+			e.WebMessageReceivedCallback(source, json)
+		}
+	}
 	sender.vtbl.PostWebMessageAsString.Call(
 		uintptr(unsafe.Pointer(sender)),
 		uintptr(unsafe.Pointer(message)),
@@ -223,17 +764,14 @@ func (e *Chromium) MessageReceived(sender *ICoreWebView2, args *iCoreWebView2Web
 	return 0
 }
 
-func (e *Chromium) PermissionRequested(_ *ICoreWebView2, args *iCoreWebView2PermissionRequestedEventArgs) uintptr {
-	var kind _CoreWebView2PermissionKind
-	args.vtbl.GetPermissionKind.Call(
-		uintptr(unsafe.Pointer(args)),
-		uintptr(kind),
-	)
-	if kind == _CoreWebView2PermissionKindClipboardRead {
-		args.vtbl.PutState.Call(
-			uintptr(unsafe.Pointer(args)),
-			uintptr(_CoreWebView2PermissionStateAllow),
-		)
+func (e *Chromium) PermissionRequested(sender *ICoreWebView2, args *ICoreWebView2PermissionRequestedEventArgs) uintptr {
+	// Clipboard reads are allowed unless the callback decides otherwise.
+	kind, _ := args.GetPermissionKind()
+	if kind == COREWEBVIEW2_PERMISSION_KIND_CLIPBOARD_READ {
+		args.PutState(COREWEBVIEW2_PERMISSION_STATE_ALLOW)
+	}
+	if e.PermissionRequestedCallback != nil {
+		e.PermissionRequestedCallback(sender, args)
 	}
 	return 0
 }
@@ -249,6 +787,32 @@ func (e *Chromium) WebResourceRequested(sender *ICoreWebView2, args *ICoreWebVie
 	return 0
 }
 
+func (e *Chromium) WebResourceResponseReceived(sender *ICoreWebView2, args *ICoreWebView2WebResourceResponseReceivedEventArgs) uintptr {
+	if e.WebResourceResponseReceivedCallback != nil {
+		e.WebResourceResponseReceivedCallback(sender, args)
+	}
+	return 0
+}
+
+func (e *Chromium) DownloadStarting(sender *ICoreWebView2, args *ICoreWebView2DownloadStartingEventArgs) uintptr {
+	if op, err := args.GetDownloadOperation(); err == nil {
+		var token _EventRegistrationToken
+		op.AddStateChanged(e.downloadStateChanged, &token)
+		op.Release()
+	}
+	if e.DownloadStartingCallback != nil {
+		e.DownloadStartingCallback(sender, args)
+	}
+	return 0
+}
+
+func (e *Chromium) DownloadStateChanged(sender *ICoreWebView2DownloadOperation, _ *_IUnknown) uintptr {
+	if e.DownloadStateChangedCallback != nil {
+		e.DownloadStateChangedCallback(sender)
+	}
+	return 0
+}
+
 func (e *Chromium) AddWebResourceRequestedFilter(filter string, ctx COREWEBVIEW2_WEB_RESOURCE_CONTEXT) {
 	err := e.webview.AddWebResourceRequestedFilter(filter, ctx)
 	if err != nil {
@@ -256,6 +820,12 @@ func (e *Chromium) AddWebResourceRequestedFilter(filter string, ctx COREWEBVIEW2
 	}
 }
 
+// RemoveWebResourceRequestedFilter removes a filter added with
+// AddWebResourceRequestedFilter.
+func (e *Chromium) RemoveWebResourceRequestedFilter(filter string, ctx COREWEBVIEW2_WEB_RESOURCE_CONTEXT) error {
+	return e.webview.RemoveWebResourceRequestedFilter(filter, ctx)
+}
+
 func (e *Chromium) Environment() *ICoreWebView2Environment {
 	return e.environment
 }
@@ -284,6 +854,204 @@ func (e *Chromium) GetSettings() (*ICoreWebView2Settings, error) {
 	return e.webview.GetSettings()
 }
 
+// GetUserAgent returns the User-Agent the webview sends. It returns
+// ErrNotSupported on runtimes that can not change it.
+func (e *Chromium) GetUserAgent() (string, error) {
+	settings, err := e.webview.GetSettings()
+	if err != nil {
+		return "", err
+	}
+	settings2 := settings.GetICoreWebView2Settings2()
+	if settings2 == nil {
+		return "", ErrNotSupported
+	}
+	defer settings2.Release()
+	return settings2.GetUserAgent()
+}
+
+// PutUserAgent sets the User-Agent the webview sends from the next request
+// on.
+func (e *Chromium) PutUserAgent(userAgent string) error {
+	settings, err := e.webview.GetSettings()
+	if err != nil {
+		return err
+	}
+	settings2 := settings.GetICoreWebView2Settings2()
+	if settings2 == nil {
+		return ErrNotSupported
+	}
+	defer settings2.Release()
+	return settings2.PutUserAgent(userAgent)
+}
+
+// OpenDevToolsWindow opens the DevTools window of the webview, or focuses it
+// if it is already open. It does nothing unless Debug is set.
+func (e *Chromium) OpenDevToolsWindow() error {
+	return e.webview.OpenDevToolsWindow()
+}
+
+// CallDevToolsProtocolMethod runs the DevTools Protocol method with the JSON
+// object params and calls completed on the UI thread with the JSON result.
+func (e *Chromium) CallDevToolsProtocolMethod(method, params string, completed func(result string, err error)) error {
+	return e.webview.CallDevToolsProtocolMethod(method, params, completed)
+}
+
+// AddDevToolsProtocolEventReceived calls callback on the UI thread with the
+// JSON parameters of every DevTools Protocol event named eventName. The
+// returned function, which must be called on the UI thread, removes callback
+// again.
+func (e *Chromium) AddDevToolsProtocolEventReceived(eventName string, callback func(params string)) (func(), error) {
+	receiver, err := e.webview.GetDevToolsProtocolEventReceiver(eventName)
+	if err != nil {
+		return nil, err
+	}
+	handler := newICoreWebView2DevToolsProtocolEventReceivedEventHandler(func(args *ICoreWebView2DevToolsProtocolEventReceivedEventArgs) {
+		params, err := args.GetParameterObjectAsJson()
+		if err != nil {
+			log.Printf("DevToolsProtocolEventReceived: %v", err)
+			return
+		}
+		callback(params)
+	})
+	var token _EventRegistrationToken
+	if err := receiver.AddDevToolsProtocolEventReceived(handler, &token); err != nil {
+		unpin(unsafe.Pointer(handler))
+		receiver.Release()
+		return nil, err
+	}
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			receiver.RemoveDevToolsProtocolEventReceived(token)
+			receiver.Release()
+			unpin(unsafe.Pointer(handler))
+		})
+	}, nil
+}
+
+// CapturePreview takes an image of the visible part of the page and calls
+// completed on the UI thread with the encoded image.
+func (e *Chromium) CapturePreview(imageFormat COREWEBVIEW2_CAPTURE_PREVIEW_IMAGE_FORMAT, completed func(image []byte, err error)) error {
+	stream, err := NewMemoryStream(nil)
+	if err != nil {
+		return err
+	}
+	err = e.webview.CapturePreview(imageFormat, stream, func(err error) {
+		defer stream.Release()
+		if err != nil {
+			completed(nil, err)
+			return
+		}
+		completed(stream.Bytes())
+	})
+	if err != nil {
+		stream.Release()
+	}
+	return err
+}
+
+// CreatePrintSettings returns new print settings for PrintToPdf with the
+// WebView2 defaults. The caller must Release the result.
+func (e *Chromium) CreatePrintSettings() (*ICoreWebView2PrintSettings, error) {
+	environment6 := e.environment.GetICoreWebView2Environment6()
+	if environment6 == nil {
+		return nil, ErrNotSupported
+	}
+	defer environment6.Release()
+	return environment6.CreatePrintSettings()
+}
+
+// PrintToPdf saves the page as a PDF file at path and calls completed on the
+// UI thread once it is done. printSettings may be nil for the defaults.
+func (e *Chromium) PrintToPdf(path string, printSettings *ICoreWebView2PrintSettings, completed func(err error)) error {
+	webview7 := e.webview.GetICoreWebView2_7()
+	if webview7 == nil {
+		return ErrNotSupported
+	}
+	defer webview7.Release()
+	return webview7.PrintToPdf(path, printSettings, func(isSuccessful bool, err error) {
+		if err == nil && !isSuccessful {
+			err = errors.New("printing to PDF failed")
+		}
+		completed(err)
+	})
+}
+
+// PrintToPdfStream renders the page as a PDF and calls completed on the UI
+// thread with its content. printSettings may be nil for the defaults.
+func (e *Chromium) PrintToPdfStream(printSettings *ICoreWebView2PrintSettings, completed func(pdf []byte, err error)) error {
+	webview16 := e.webview.GetICoreWebView2_16()
+	if webview16 == nil {
+		return ErrNotSupported
+	}
+	defer webview16.Release()
+	return webview16.PrintToPdfStream(printSettings, func(stream *IStream, err error) {
+		if err != nil {
+			completed(nil, err)
+			return
+		}
+		completed(stream.Bytes())
+	})
+}
+
+// Print prints the page without a dialog and calls completed on the UI
+// thread with the result. printSettings may be nil to use the default
+// printer.
+func (e *Chromium) Print(printSettings *ICoreWebView2PrintSettings, completed func(printStatus COREWEBVIEW2_PRINT_STATUS, err error)) error {
+	webview16 := e.webview.GetICoreWebView2_16()
+	if webview16 == nil {
+		return ErrNotSupported
+	}
+	defer webview16.Release()
+	return webview16.Print(printSettings, completed)
+}
+
+// GetBrowserProcessID returns the ID of the browser process of the webview.
+func (e *Chromium) GetBrowserProcessID() (uint32, error) {
+	return e.webview.GetBrowserProcessID()
+}
+
+// GoBack navigates to the previous page in the history, if there is one.
+func (e *Chromium) GoBack() error {
+	if ok, err := e.webview.GetCanGoBack(); err != nil || !ok {
+		return err
+	}
+	return e.webview.GoBack()
+}
+
+// GoForward navigates to the next page in the history, if there is one.
+func (e *Chromium) GoForward() error {
+	if ok, err := e.webview.GetCanGoForward(); err != nil || !ok {
+		return err
+	}
+	return e.webview.GoForward()
+}
+
+func (e *Chromium) Reload() error {
+	return e.webview.Reload()
+}
+
+func (e *Chromium) Stop() error {
+	return e.webview.Stop()
+}
+
+// PostWebMessageAsJSON sends a message that the page receives as a parsed
+// object through window.chrome.webview's message event.
+func (e *Chromium) PostWebMessageAsJSON(webMessageAsJSON string) error {
+	return e.webview.PostWebMessageAsJSON(webMessageAsJSON)
+}
+
+// PostWebMessageAsString sends a message that the page receives as a string
+// through window.chrome.webview's message event.
+func (e *Chromium) PostWebMessageAsString(webMessageAsString string) error {
+	return e.webview.PostWebMessageAsString(webMessageAsString)
+}
+
+// GetSource returns the URI of the top-level document.
+func (e *Chromium) GetSource() (string, error) {
+	return e.webview.GetSource()
+}
+
 func boolToInt(input bool) int {
 	if input {
 		return 1
@@ -297,3 +1065,266 @@ func (e *Chromium) NavigationCompleted(sender *ICoreWebView2, args *ICoreWebView
 	}
 	return 0
 }
+
+func (e *Chromium) NavigationStarting(sender *ICoreWebView2, args *ICoreWebView2NavigationStartingEventArgs) uintptr {
+	if e.NavigationStartingCallback != nil {
+		e.NavigationStartingCallback(sender, args)
+	}
+	return 0
+}
+
+func (e *Chromium) FrameNavigationStarting(sender *ICoreWebView2, args *ICoreWebView2NavigationStartingEventArgs) uintptr {
+	if e.FrameNavigationStartingCallback != nil {
+		e.FrameNavigationStartingCallback(sender, args)
+	}
+	return 0
+}
+
+func (e *Chromium) BasicAuthenticationRequested(sender *ICoreWebView2, args *ICoreWebView2BasicAuthenticationRequestedEventArgs) uintptr {
+	if e.BasicAuthenticationRequestedCallback != nil {
+		e.BasicAuthenticationRequestedCallback(sender, args)
+	}
+	return 0
+}
+
+func (e *Chromium) ServerCertificateErrorDetected(sender *ICoreWebView2, args *ICoreWebView2ServerCertificateErrorDetectedEventArgs) uintptr {
+	if e.ServerCertificateErrorDetectedCallback != nil {
+		e.ServerCertificateErrorDetectedCallback(sender, args)
+	}
+	return 0
+}
+
+func (e *Chromium) DocumentTitleChanged(sender *ICoreWebView2, _ *_IUnknown) uintptr {
+	if e.DocumentTitleChangedCallback != nil {
+		e.DocumentTitleChangedCallback(sender)
+	}
+	return 0
+}
+
+func (e *Chromium) HistoryChanged(sender *ICoreWebView2, _ *_IUnknown) uintptr {
+	if e.HistoryChangedCallback != nil {
+		e.HistoryChangedCallback(sender)
+	}
+	return 0
+}
+
+func (e *Chromium) ZoomFactorChanged(sender *iCoreWebView2Controller, _ *_IUnknown) uintptr {
+	if e.ZoomFactorChangedCallback != nil {
+		zoomFactor, _ := sender.GetZoomFactor()
+		e.ZoomFactorChangedCallback(zoomFactor)
+	}
+	return 0
+}
+
+func (e *Chromium) GetZoomFactor() (float64, error) {
+	return e.controller.GetZoomFactor()
+}
+
+func (e *Chromium) PutZoomFactor(zoomFactor float64) error {
+	return e.controller.PutZoomFactor(zoomFactor)
+}
+
+// PutDefaultBackgroundColor sets the color shown where the page does not
+// paint, including before the first page has loaded. WebView2 only supports
+// an alpha of 0 or 255.
+func (e *Chromium) PutDefaultBackgroundColor(color COREWEBVIEW2_COLOR) error {
+	controller2 := e.controller.getICoreWebView2Controller2()
+	if controller2 == nil {
+		return ErrNotSupported
+	}
+	defer controller2.Release()
+	return controller2.PutDefaultBackgroundColor(color)
+}
+
+// GetIsMuted reports whether the audio of the webview is muted.
+func (e *Chromium) GetIsMuted() (bool, error) {
+	webview8 := e.webview.GetICoreWebView2_8()
+	if webview8 == nil {
+		return false, ErrNotSupported
+	}
+	defer webview8.Release()
+	return webview8.GetIsMuted()
+}
+
+// PutIsMuted mutes or unmutes the audio of the webview.
+func (e *Chromium) PutIsMuted(muted bool) error {
+	webview8 := e.webview.GetICoreWebView2_8()
+	if webview8 == nil {
+		return ErrNotSupported
+	}
+	defer webview8.Release()
+	return webview8.PutIsMuted(muted)
+}
+
+// GetIsDocumentPlayingAudio reports whether the page is playing audio, even if
+// it is muted.
+func (e *Chromium) GetIsDocumentPlayingAudio() (bool, error) {
+	webview8 := e.webview.GetICoreWebView2_8()
+	if webview8 == nil {
+		return false, ErrNotSupported
+	}
+	defer webview8.Release()
+	return webview8.GetIsDocumentPlayingAudio()
+}
+
+// GetDocumentTitle returns the title of the top-level document.
+func (e *Chromium) GetDocumentTitle() (string, error) {
+	return e.webview.GetDocumentTitle()
+}
+
+func (e *Chromium) WindowCloseRequested(sender *ICoreWebView2, _ *_IUnknown) uintptr {
+	if e.WindowCloseRequestedCallback != nil {
+		e.WindowCloseRequestedCallback(sender)
+	}
+	return 0
+}
+
+func (e *Chromium) NewWindowRequested(sender *ICoreWebView2, args *ICoreWebView2NewWindowRequestedEventArgs) uintptr {
+	if e.NewWindowRequestedCallback != nil {
+		e.NewWindowRequestedCallback(sender, args)
+	}
+	return 0
+}
+
+// CoreWebView returns the underlying ICoreWebView2, or nil before Embed has
+// succeeded.
+func (e *Chromium) CoreWebView() *ICoreWebView2 {
+	return e.webview
+}
+
+func (e *Chromium) ScriptDialogOpening(sender *ICoreWebView2, args *ICoreWebView2ScriptDialogOpeningEventArgs) uintptr {
+	if e.ScriptDialogOpeningCallback != nil {
+		e.ScriptDialogOpeningCallback(sender, args)
+	}
+	return 0
+}
+
+func (e *Chromium) ContextMenuRequested(sender *ICoreWebView2, args *ICoreWebView2ContextMenuRequestedEventArgs) uintptr {
+	if e.ContextMenuRequestedCallback != nil {
+		e.ContextMenuRequestedCallback(sender, args)
+	}
+	return 0
+}
+
+func (e *Chromium) CustomItemSelected(item *ICoreWebView2ContextMenuItem, _ *_IUnknown) uintptr {
+	if e.CustomItemSelectedCallback != nil {
+		e.CustomItemSelectedCallback(item)
+	}
+	return 0
+}
+
+// CreateContextMenuItem creates a custom context menu item. Selecting it calls
+// CustomItemSelectedCallback. It returns ErrNotSupported if the runtime is too
+// old for custom context menus.
+func (e *Chromium) CreateContextMenuItem(label string, kind COREWEBVIEW2_CONTEXT_MENU_ITEM_KIND) (*ICoreWebView2ContextMenuItem, error) {
+	environment9 := e.environment.GetICoreWebView2Environment9()
+	if environment9 == nil {
+		return nil, ErrNotSupported
+	}
+	defer environment9.Release()
+	item, err := environment9.CreateContextMenuItem(label, kind)
+	if err != nil {
+		return nil, err
+	}
+	var token _EventRegistrationToken
+	item.AddCustomItemSelected(e.customItemSelected, &token)
+	return item, nil
+}
+
+func (e *Chromium) IsMutedChanged(sender *ICoreWebView2, _ *_IUnknown) uintptr {
+	if e.IsMutedChangedCallback != nil {
+		e.IsMutedChangedCallback(sender)
+	}
+	return 0
+}
+
+func (e *Chromium) IsDocumentPlayingAudioChanged(sender *ICoreWebView2, _ *_IUnknown) uintptr {
+	if e.IsDocumentPlayingAudioChangedCallback != nil {
+		e.IsDocumentPlayingAudioChangedCallback(sender)
+	}
+	return 0
+}
+
+func (e *Chromium) ContainsFullScreenElementChanged(sender *ICoreWebView2, _ *_IUnknown) uintptr {
+	if e.ContainsFullScreenElementChangedCallback != nil {
+		e.ContainsFullScreenElementChangedCallback(sender)
+	}
+	return 0
+}
+
+func (e *Chromium) ProcessFailed(sender *ICoreWebView2, args *ICoreWebView2ProcessFailedEventArgs) uintptr {
+	if e.ProcessFailedCallback != nil {
+		e.ProcessFailedCallback(sender, args)
+	}
+	return 0
+}
+
+// profile returns the profile of the webview. It returns ErrNotSupported on
+// runtimes without profile support. The caller must Release the result.
+func (e *Chromium) profile() (*ICoreWebView2Profile, error) {
+	webview13 := e.webview.GetICoreWebView2_13()
+	if webview13 == nil {
+		return nil, ErrNotSupported
+	}
+	defer webview13.Release()
+	return webview13.GetProfile()
+}
+
+// IsInPrivateModeEnabled reports whether the webview runs in an InPrivate
+// profile. It returns ErrNotSupported on runtimes without profile support.
+func (e *Chromium) IsInPrivateModeEnabled() (bool, error) {
+	profile, err := e.profile()
+	if err != nil {
+		return false, err
+	}
+	defer profile.Release()
+	return profile.GetIsInPrivateModeEnabled()
+}
+
+// GetDefaultDownloadFolderPath returns the folder downloads are saved to.
+func (e *Chromium) GetDefaultDownloadFolderPath() (string, error) {
+	profile, err := e.profile()
+	if err != nil {
+		return "", err
+	}
+	defer profile.Release()
+	return profile.GetDefaultDownloadFolderPath()
+}
+
+// PutDefaultDownloadFolderPath sets the folder downloads are saved to. path
+// must be absolute.
+func (e *Chromium) PutDefaultDownloadFolderPath(path string) error {
+	profile, err := e.profile()
+	if err != nil {
+		return err
+	}
+	defer profile.Release()
+	return profile.PutDefaultDownloadFolderPath(path)
+}
+
+// PutPreferredColorScheme sets the color scheme pages of the webview's profile
+// see in the prefers-color-scheme media query.
+func (e *Chromium) PutPreferredColorScheme(scheme COREWEBVIEW2_PREFERRED_COLOR_SCHEME) error {
+	profile, err := e.profile()
+	if err != nil {
+		return err
+	}
+	defer profile.Release()
+	return profile.PutPreferredColorScheme(scheme)
+}
+
+// ClearBrowsingData deletes the given kinds of browsing data of the
+// webview's profile and calls completed on the UI thread once it is done.
+func (e *Chromium) ClearBrowsingData(dataKinds COREWEBVIEW2_BROWSING_DATA_KINDS, completed func(err error)) error {
+	profile, err := e.profile()
+	if err != nil {
+		return err
+	}
+	defer profile.Release()
+	profile2 := profile.GetICoreWebView2Profile2()
+	if profile2 == nil {
+		return ErrNotSupported
+	}
+	defer profile2.Release()
+	return profile2.ClearBrowsingData(dataKinds, completed)
+}
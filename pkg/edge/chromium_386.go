@@ -4,7 +4,11 @@
 package edge
 
 import (
+	"math"
 	"unsafe"
+
+	"github.com/project-vrcat/go-webview2/internal/w32"
+	"golang.org/x/sys/windows"
 )
 
 func (e *Chromium) Resize() {
@@ -21,3 +25,78 @@ func (e *Chromium) Resize() {
 		uintptr(bounds.Bottom),
 	)
 }
+
+func (i *iCoreWebView2Controller) PutZoomFactor(zoomFactor float64) error {
+	var err error
+
+	bits := math.Float64bits(zoomFactor)
+	_, _, err = i.vtbl.PutZoomFactor.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(bits),
+		uintptr(bits>>32),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2Cookie) PutExpires(expires float64) error {
+	var err error
+
+	bits := math.Float64bits(expires)
+	_, _, err = i.vtbl.PutExpires.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(bits),
+		uintptr(bits>>32),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *IStream) Seek(offset int64, whence int) (int64, error) {
+	var position int64
+	hr, _, _ := i.vtbl.Seek.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(uint32(offset)),
+		uintptr(uint32(offset>>32)),
+		uintptr(whence),
+		uintptr(unsafe.Pointer(&position)),
+	)
+	if int32(hr) < 0 {
+		return 0, windows.Errno(hr)
+	}
+	return position, nil
+}
+
+// putFloat64 calls a COM property setter that takes a double, which takes two
+// stack slots on 386.
+func putFloat64(proc ComProc, this unsafe.Pointer, value float64) error {
+	var err error
+
+	bits := math.Float64bits(value)
+	_, _, err = proc.Call(
+		uintptr(this),
+		uintptr(bits),
+		uintptr(bits>>32),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2DevToolsProtocolEventReceiver) RemoveDevToolsProtocolEventReceived(token _EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.RemoveDevToolsProtocolEventReceived.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(token.value),
+		uintptr(token.value>>32),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
@@ -4,9 +4,11 @@
 package edge
 
 import (
+	"math"
 	"unsafe"
 
 	"github.com/project-vrcat/go-webview2/internal/w32"
+	"golang.org/x/sys/windows"
 )
 
 func (e *Chromium) Resize() {
@@ -20,3 +22,71 @@ func (e *Chromium) Resize() {
 		uintptr(unsafe.Pointer(&bounds)),
 	)
 }
+
+func (i *iCoreWebView2Controller) PutZoomFactor(zoomFactor float64) error {
+	var err error
+
+	// Floating point arguments are passed in XMM registers, which the
+	// syscall trampoline fills from the integer arguments.
+	_, _, err = i.vtbl.PutZoomFactor.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(math.Float64bits(zoomFactor)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2Cookie) PutExpires(expires float64) error {
+	var err error
+
+	_, _, err = i.vtbl.PutExpires.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(math.Float64bits(expires)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *IStream) Seek(offset int64, whence int) (int64, error) {
+	var position int64
+	hr, _, _ := i.vtbl.Seek.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(offset),
+		uintptr(whence),
+		uintptr(unsafe.Pointer(&position)),
+	)
+	if int32(hr) < 0 {
+		return 0, windows.Errno(hr)
+	}
+	return position, nil
+}
+
+// putFloat64 calls a COM property setter that takes a double.
+func putFloat64(proc ComProc, this unsafe.Pointer, value float64) error {
+	var err error
+
+	_, _, err = proc.Call(
+		uintptr(this),
+		uintptr(math.Float64bits(value)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2DevToolsProtocolEventReceiver) RemoveDevToolsProtocolEventReceived(token _EventRegistrationToken) error {
+	var err error
+	_, _, err = i.vtbl.RemoveDevToolsProtocolEventReceived.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(token.value),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
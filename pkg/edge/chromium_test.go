@@ -0,0 +1,132 @@
+//go:build windows
+// +build windows
+
+package edge
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestProxyArguments(t *testing.T) {
+	tests := []struct {
+		config ProxyConfig
+		want   []string
+	}{
+		{ProxyConfig{Type: ProxyTypeSystem}, nil},
+		{ProxyConfig{Type: ProxyTypeDirect}, []string{"--no-proxy-server"}},
+		{ProxyConfig{Type: ProxyTypeAutoDetect}, []string{"--proxy-auto-detect"}},
+		{ProxyConfig{Type: ProxyTypeFixedServers, Server: "http://proxy:8080"}, []string{"--proxy-server=http://proxy:8080"}},
+		{ProxyConfig{Type: ProxyTypeFixedServers, Server: "proxy:80", BypassList: "localhost;*.corp"}, []string{
+			"--proxy-server=proxy:80",
+			"--proxy-bypass-list=localhost;*.corp",
+		}},
+		{ProxyConfig{Type: ProxyTypePAC, PACScriptURL: "http://wpad/proxy.pac"}, []string{"--proxy-pac-url=http://wpad/proxy.pac"}},
+	}
+	for _, tt := range tests {
+		if got := tt.config.proxyArguments(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("proxyArguments(%+v) = %q, want %q", tt.config, got, tt.want)
+		}
+	}
+}
+
+func TestSetProxy(t *testing.T) {
+	tests := []struct {
+		config  ProxyConfig
+		wantErr bool
+	}{
+		{ProxyConfig{Type: ProxyTypeSystem}, false},
+		{ProxyConfig{Type: ProxyTypeDirect}, false},
+		{ProxyConfig{Type: ProxyTypeFixedServers, Server: "proxy:80"}, false},
+		{ProxyConfig{Type: ProxyTypeFixedServers}, true},
+		{ProxyConfig{Type: ProxyTypePAC, PACScriptURL: "http://wpad/proxy.pac"}, false},
+		{ProxyConfig{Type: ProxyTypePAC}, true},
+		{ProxyConfig{Type: ProxyType(100)}, true},
+	}
+	for _, tt := range tests {
+		var o EnvironmentOptions
+		err := o.SetProxy(tt.config)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("SetProxy(%+v) error = %v, want error %v", tt.config, err, tt.wantErr)
+		}
+		if err == nil && o.Proxy != tt.config {
+			t.Errorf("SetProxy(%+v) set %+v", tt.config, o.Proxy)
+		}
+		if err != nil && o.Proxy != (ProxyConfig{}) {
+			t.Errorf("SetProxy(%+v) failed but set %+v", tt.config, o.Proxy)
+		}
+	}
+}
+
+func TestSetMediaAutoplay(t *testing.T) {
+	o := EnvironmentOptions{AdditionalBrowserArguments: []string{"--a", "--autoplay-policy=user-gesture-required", "--b"}}
+	given := o.AdditionalBrowserArguments
+	o.SetMediaAutoplay(AutoplayPolicyNoUserGestureRequired)
+	want := []string{"--a", "--b", "--autoplay-policy=no-user-gesture-required"}
+	if !reflect.DeepEqual(o.AdditionalBrowserArguments, want) {
+		t.Errorf("AdditionalBrowserArguments = %q, want %q", o.AdditionalBrowserArguments, want)
+	}
+	if given[1] != "--autoplay-policy=user-gesture-required" {
+		t.Errorf("SetMediaAutoplay changed the given arguments to %q", given)
+	}
+
+	for policy, value := range map[AutoplayPolicy]string{
+		AutoplayPolicyUserGestureRequired:            "user-gesture-required",
+		AutoplayPolicyNoUserGestureRequired:          "no-user-gesture-required",
+		AutoplayPolicyDocumentUserActivationRequired: "document-user-activation-required",
+	} {
+		var o EnvironmentOptions
+		o.SetMediaAutoplay(policy)
+		if want := []string{"--autoplay-policy=" + value}; !reflect.DeepEqual(o.AdditionalBrowserArguments, want) {
+			t.Errorf("SetMediaAutoplay(%d) = %q, want %q", policy, o.AdditionalBrowserArguments, want)
+		}
+	}
+}
+
+func TestEnvironmentOptionsIsZero(t *testing.T) {
+	off := false
+	if o := (EnvironmentOptions{}); !o.isZero() {
+		t.Error("zero EnvironmentOptions is not zero")
+	}
+	for _, o := range []EnvironmentOptions{
+		{Language: "en-US"},
+		{EnableTrackingPrevention: &off},
+		{DisableHardwareAcceleration: true},
+		{Proxy: ProxyConfig{Type: ProxyTypeDirect}},
+	} {
+		if o.isZero() {
+			t.Errorf("%+v is zero", o)
+		}
+	}
+}
+
+func TestNewICoreWebView2EnvironmentOptions(t *testing.T) {
+	on, off := true, false
+	tests := []struct {
+		name     string
+		opts     EnvironmentOptions
+		args     string
+		tracking bool
+	}{
+		{"zero", EnvironmentOptions{}, "", true},
+		{"language only", EnvironmentOptions{Language: "en-US"}, "", true},
+		{"tracking prevention on", EnvironmentOptions{EnableTrackingPrevention: &on}, "", true},
+		{"tracking prevention off", EnvironmentOptions{EnableTrackingPrevention: &off}, "", false},
+		{"arguments", EnvironmentOptions{
+			AdditionalBrowserArguments:  []string{"--a"},
+			Proxy:                       ProxyConfig{Type: ProxyTypeDirect},
+			DisableHardwareAcceleration: true,
+		}, "--a --no-proxy-server --disable-gpu --disable-gpu-compositing --software-rendering-fallback", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := newICoreWebView2EnvironmentOptions(tt.opts)
+			if options.additionalBrowserArguments != tt.args {
+				t.Errorf("additionalBrowserArguments = %q, want %q", options.additionalBrowserArguments, tt.args)
+			}
+			if options.enableTrackingPrevention != tt.tracking {
+				t.Errorf("enableTrackingPrevention = %v, want %v", options.enableTrackingPrevention, tt.tracking)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+//go:build windows
+// +build windows
+
+package edge
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Cookie is an HTTP cookie of the webview's profile.
+type Cookie struct {
+	Name       string
+	Value      string
+	Domain     string
+	Path       string
+	IsSecure   bool
+	IsHttpOnly bool
+	SameSite   COREWEBVIEW2_COOKIE_SAME_SITE_KIND
+
+	// Expires is when the cookie expires; the zero time means a session
+	// cookie
+	Expires time.Time
+}
+
+// CookieManager reads and changes the cookies of a webview's profile. Its
+// methods must be called on the UI thread.
+type CookieManager struct {
+	manager *ICoreWebView2CookieManager
+}
+
+// CookieManager returns the cookie manager of the webview.
+func (e *Chromium) CookieManager() (*CookieManager, error) {
+	if e.cookieManager != nil {
+		return e.cookieManager, nil
+	}
+	webview2 := e.webview.GetICoreWebView2_2()
+	if webview2 == nil {
+		return nil, ErrNotSupported
+	}
+	defer webview2.Release()
+	manager, err := webview2.GetCookieManager()
+	if err != nil {
+		return nil, err
+	}
+	e.cookieManager = &CookieManager{manager: manager}
+	return e.cookieManager, nil
+}
+
+// AddCookie adds c, or replaces the cookie with the same name, domain and
+// path.
+func (m *CookieManager) AddCookie(c Cookie) error {
+	cookie, err := m.manager.CreateCookie(c.Name, c.Value, c.Domain, c.Path)
+	if err != nil {
+		return err
+	}
+	defer cookie.Release()
+	if err := cookie.PutIsSecure(c.IsSecure); err != nil {
+		return err
+	}
+	if err := cookie.PutIsHttpOnly(c.IsHttpOnly); err != nil {
+		return err
+	}
+	if err := cookie.PutSameSite(c.SameSite); err != nil {
+		return err
+	}
+	if !c.Expires.IsZero() {
+		expires := float64(c.Expires.UnixNano()) / float64(time.Second)
+		if err := cookie.PutExpires(expires); err != nil {
+			return err
+		}
+	}
+	return m.manager.AddOrUpdateCookie(cookie)
+}
+
+// GetCookies returns the cookies that would be sent to url, or all cookies
+// when url is empty. It runs the message loop until WebView2 has answered.
+func (m *CookieManager) GetCookies(url string) ([]Cookie, error) {
+	var done uintptr
+	var cookies []Cookie
+	var result error
+	err := m.manager.GetCookies(url, func(cookieList *ICoreWebView2CookieList, err error) {
+		defer atomic.StoreUintptr(&done, 1)
+		if err != nil {
+			result = err
+			return
+		}
+		cookies, result = readCookies(cookieList)
+	})
+	if err != nil {
+		return nil, err
+	}
+	pumpUntil(&done)
+	return cookies, result
+}
+
+func readCookies(cookieList *ICoreWebView2CookieList) ([]Cookie, error) {
+	count, err := cookieList.GetCount()
+	if err != nil {
+		return nil, err
+	}
+	cookies := make([]Cookie, 0, count)
+	for i := uint32(0); i < count; i++ {
+		cookie, err := cookieList.GetValueAtIndex(i)
+		if err != nil {
+			return nil, err
+		}
+		c, err := readCookie(cookie)
+		cookie.Release()
+		if err != nil {
+			return nil, err
+		}
+		cookies = append(cookies, c)
+	}
+	return cookies, nil
+}
+
+func readCookie(cookie *ICoreWebView2Cookie) (Cookie, error) {
+	var c Cookie
+	var err error
+	if c.Name, err = cookie.GetName(); err != nil {
+		return c, err
+	}
+	if c.Value, err = cookie.GetValue(); err != nil {
+		return c, err
+	}
+	if c.Domain, err = cookie.GetDomain(); err != nil {
+		return c, err
+	}
+	if c.Path, err = cookie.GetPath(); err != nil {
+		return c, err
+	}
+	if c.IsSecure, err = cookie.GetIsSecure(); err != nil {
+		return c, err
+	}
+	if c.IsHttpOnly, err = cookie.GetIsHttpOnly(); err != nil {
+		return c, err
+	}
+	if c.SameSite, err = cookie.GetSameSite(); err != nil {
+		return c, err
+	}
+	session, err := cookie.GetIsSession()
+	if err != nil {
+		return c, err
+	}
+	if !session {
+		expires, err := cookie.GetExpires()
+		if err != nil {
+			return c, err
+		}
+		c.Expires = time.Unix(0, int64(expires*float64(time.Second)))
+	}
+	return c, nil
+}
+
+// DeleteCookie deletes the cookie with the name, domain and path of c.
+func (m *CookieManager) DeleteCookie(c Cookie) error {
+	return m.manager.DeleteCookiesWithDomainAndPath(c.Name, c.Domain, c.Path)
+}
+
+// DeleteAllCookies deletes every cookie of the profile.
+func (m *CookieManager) DeleteAllCookies() error {
+	return m.manager.DeleteAllCookies()
+}
@@ -4,8 +4,10 @@
 package edge
 
 import (
+	"errors"
 	"log"
 	"runtime"
+	"sync"
 	"syscall"
 	"unsafe"
 
@@ -28,26 +30,6 @@ type _EventRegistrationToken struct {
 	value int64
 }
 
-type _CoreWebView2PermissionKind uint32
-
-const (
-	_CoreWebView2PermissionKindUnknownPermission _CoreWebView2PermissionKind = iota
-	_CoreWebView2PermissionKindMicrophone
-	_CoreWebView2PermissionKindCamera
-	_CoreWebView2PermissionKindGeolocation
-	_CoreWebView2PermissionKindNotifications
-	_CoreWebView2PermissionKindOtherSensors
-	_CoreWebView2PermissionKindClipboardRead
-)
-
-type _CoreWebView2PermissionState uint32
-
-const (
-	_CoreWebView2PermissionStateDefault _CoreWebView2PermissionState = iota
-	_CoreWebView2PermissionStateAllow
-	_CoreWebView2PermissionStateDeny
-)
-
 func createCoreWebView2EnvironmentWithOptions(browserExecutableFolder, userDataFolder *uint16, environmentOptions uintptr, environmentCompletedHandle *iCoreWebView2CreateCoreWebView2EnvironmentCompletedHandler) (uintptr, error) {
 	return webviewloader.CreateCoreWebView2EnvironmentWithOptions(
 		browserExecutableFolder,
@@ -57,6 +39,26 @@ func createCoreWebView2EnvironmentWithOptions(browserExecutableFolder, userDataF
 	)
 }
 
+var (
+	pinned     = map[unsafe.Pointer]struct{}{}
+	pinnedSync sync.Mutex
+)
+
+// pin keeps a Go-allocated COM object reachable while only the WebView2
+// runtime holds a pointer to it. Single-use completion handlers unpin
+// themselves once invoked.
+func pin(p unsafe.Pointer) {
+	pinnedSync.Lock()
+	defer pinnedSync.Unlock()
+	pinned[p] = struct{}{}
+}
+
+func unpin(p unsafe.Pointer) {
+	pinnedSync.Lock()
+	defer pinnedSync.Unlock()
+	delete(pinned, p)
+}
+
 // ComProc stores a COM procedure.
 type ComProc uintptr
 
@@ -113,6 +115,38 @@ type _IUnknownVtbl struct {
 	Release        ComProc
 }
 
+type _IUnknown struct {
+	vtbl *_IUnknownVtbl
+}
+
+// ErrNotSupported is returned when the installed WebView2 runtime does not
+// implement the interface a feature needs.
+var ErrNotSupported = errors.New("not supported by the installed WebView2 runtime")
+
+// queryInterface asks the COM object at obj for the interface iid and stores
+// it in out. It reports whether the object implements the interface.
+func queryInterface(obj unsafe.Pointer, iid *windows.GUID, out unsafe.Pointer) bool {
+	unknown := (*_IUnknown)(obj)
+	hr, _, _ := unknown.vtbl.QueryInterface.Call(
+		uintptr(obj),
+		uintptr(unsafe.Pointer(iid)),
+		uintptr(out),
+	)
+	return int32(hr) >= 0
+}
+
+// addRef adds a reference to the COM object at obj.
+func addRef(obj unsafe.Pointer) {
+	unknown := (*_IUnknown)(obj)
+	unknown.vtbl.AddRef.Call(uintptr(obj))
+}
+
+// release drops a reference to the COM object at obj.
+func release(obj unsafe.Pointer) {
+	unknown := (*_IUnknown)(obj)
+	unknown.vtbl.Release.Call(uintptr(obj))
+}
+
 type _IUnknownImpl interface {
 	QueryInterface(refiid, object uintptr) uintptr
 	AddRef() uintptr
@@ -200,6 +234,248 @@ func (i *ICoreWebView2) GetSettings() (*ICoreWebView2Settings, error) {
 	return settings, nil
 }
 
+func (i *ICoreWebView2) GetSource() (string, error) {
+	var err error
+	var _uri *uint16
+	_, _, err = i.vtbl.GetSource.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_uri)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	uri := w32.Utf16PtrToString(_uri)
+	windows.CoTaskMemFree(unsafe.Pointer(_uri))
+	return uri, nil
+}
+
+func (i *ICoreWebView2) GetDocumentTitle() (string, error) {
+	var err error
+	var _title *uint16
+	_, _, err = i.vtbl.GetDocumentTitle.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_title)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	title := w32.Utf16PtrToString(_title)
+	windows.CoTaskMemFree(unsafe.Pointer(_title))
+	return title, nil
+}
+
+func (i *ICoreWebView2) GetCanGoBack() (bool, error) {
+	var err error
+	var canGoBack int32
+	_, _, err = i.vtbl.GetCanGoBack.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&canGoBack)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	return canGoBack != 0, nil
+}
+
+func (i *ICoreWebView2) GetContainsFullScreenElement() (bool, error) {
+	var err error
+	var containsFullScreenElement int32
+	_, _, err = i.vtbl.GetContainsFullScreenElement.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&containsFullScreenElement)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	return containsFullScreenElement != 0, nil
+}
+
+func (i *ICoreWebView2) GetBrowserProcessID() (uint32, error) {
+	var err error
+	var processID uint32
+	_, _, err = i.vtbl.GetBrowserProcessID.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&processID)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return 0, err
+	}
+	return processID, nil
+}
+
+// CapturePreview writes an image of the visible part of the page to stream
+// and calls completed on the UI thread once it is done.
+func (i *ICoreWebView2) CapturePreview(imageFormat COREWEBVIEW2_CAPTURE_PREVIEW_IMAGE_FORMAT, stream *IStream, completed func(err error)) error {
+	handler := newICoreWebView2CapturePreviewCompletedHandler(func(errorCode uintptr) {
+		if int32(errorCode) < 0 {
+			completed(syscall.Errno(errorCode))
+			return
+		}
+		completed(nil)
+	})
+	hr, _, _ := i.vtbl.CapturePreview.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(imageFormat),
+		uintptr(unsafe.Pointer(stream)),
+		uintptr(unsafe.Pointer(handler)),
+	)
+	if int32(hr) < 0 {
+		unpin(unsafe.Pointer(handler))
+		return syscall.Errno(hr)
+	}
+	return nil
+}
+
+// CallDevToolsProtocolMethod runs the DevTools Protocol method with the JSON
+// object params and calls completed on the UI thread with the JSON result.
+func (i *ICoreWebView2) CallDevToolsProtocolMethod(methodName, parametersAsJson string, completed func(result string, err error)) error {
+	_methodName, err := windows.UTF16PtrFromString(methodName)
+	if err != nil {
+		return err
+	}
+	_parametersAsJson, err := windows.UTF16PtrFromString(parametersAsJson)
+	if err != nil {
+		return err
+	}
+	handler := newICoreWebView2CallDevToolsProtocolMethodCompletedHandler(func(errorCode uintptr, result string) {
+		if int32(errorCode) < 0 {
+			completed(result, syscall.Errno(errorCode))
+			return
+		}
+		completed(result, nil)
+	})
+	hr, _, _ := i.vtbl.CallDevToolsProtocolMethod.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_methodName)),
+		uintptr(unsafe.Pointer(_parametersAsJson)),
+		uintptr(unsafe.Pointer(handler)),
+	)
+	if int32(hr) < 0 {
+		unpin(unsafe.Pointer(handler))
+		return syscall.Errno(hr)
+	}
+	return nil
+}
+
+// GetDevToolsProtocolEventReceiver returns the receiver for the DevTools
+// Protocol event eventName. The caller must Release the result.
+func (i *ICoreWebView2) GetDevToolsProtocolEventReceiver(eventName string) (*ICoreWebView2DevToolsProtocolEventReceiver, error) {
+	_eventName, err := windows.UTF16PtrFromString(eventName)
+	if err != nil {
+		return nil, err
+	}
+	var receiver *ICoreWebView2DevToolsProtocolEventReceiver
+	_, _, err = i.vtbl.GetDevToolsProtocolEventReceiver.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_eventName)),
+		uintptr(unsafe.Pointer(&receiver)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return nil, err
+	}
+	return receiver, nil
+}
+
+func (i *ICoreWebView2) OpenDevToolsWindow() error {
+	var err error
+	_, _, err = i.vtbl.OpenDevToolsWindow.Call(
+		uintptr(unsafe.Pointer(i)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2) GetCanGoForward() (bool, error) {
+	var err error
+	var canGoForward int32
+	_, _, err = i.vtbl.GetCanGoForward.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&canGoForward)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return false, err
+	}
+	return canGoForward != 0, nil
+}
+
+func (i *ICoreWebView2) GoBack() error {
+	var err error
+	_, _, err = i.vtbl.GoBack.Call(
+		uintptr(unsafe.Pointer(i)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2) GoForward() error {
+	var err error
+	_, _, err = i.vtbl.GoForward.Call(
+		uintptr(unsafe.Pointer(i)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2) Reload() error {
+	var err error
+	_, _, err = i.vtbl.Reload.Call(
+		uintptr(unsafe.Pointer(i)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2) Stop() error {
+	var err error
+	_, _, err = i.vtbl.Stop.Call(
+		uintptr(unsafe.Pointer(i)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2) PostWebMessageAsJSON(webMessageAsJSON string) error {
+	var err error
+	_webMessageAsJSON, err := windows.UTF16PtrFromString(webMessageAsJSON)
+	if err != nil {
+		return err
+	}
+	_, _, err = i.vtbl.PostWebMessageAsJSON.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_webMessageAsJSON)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
+func (i *ICoreWebView2) PostWebMessageAsString(webMessageAsString string) error {
+	var err error
+	_webMessageAsString, err := windows.UTF16PtrFromString(webMessageAsString)
+	if err != nil {
+		return err
+	}
+	_, _, err = i.vtbl.PostWebMessageAsString.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_webMessageAsString)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
 // ICoreWebView2Environment
 
 type iCoreWebView2EnvironmentVtbl struct {
@@ -263,20 +539,34 @@ type iCoreWebView2WebMessageReceivedEventArgs struct {
 	vtbl *iCoreWebView2WebMessageReceivedEventArgsVtbl
 }
 
-// ICoreWebView2PermissionRequestedEventArgs
-
-type iCoreWebView2PermissionRequestedEventArgsVtbl struct {
-	_IUnknownVtbl
-	GetURI             ComProc
-	GetPermissionKind  ComProc
-	GetIsUserInitiated ComProc
-	GetState           ComProc
-	PutState           ComProc
-	GetDeferral        ComProc
+func (i *iCoreWebView2WebMessageReceivedEventArgs) GetSource() (string, error) {
+	var err error
+	var _source *uint16
+	_, _, err = i.vtbl.GetSource.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_source)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	source := w32.Utf16PtrToString(_source)
+	windows.CoTaskMemFree(unsafe.Pointer(_source))
+	return source, nil
 }
 
-type iCoreWebView2PermissionRequestedEventArgs struct {
-	vtbl *iCoreWebView2PermissionRequestedEventArgsVtbl
+func (i *iCoreWebView2WebMessageReceivedEventArgs) GetWebMessageAsJSON() (string, error) {
+	var err error
+	var _message *uint16
+	_, _, err = i.vtbl.GetWebMessageAsJSON.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(&_message)),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return "", err
+	}
+	message := w32.Utf16PtrToString(_message)
+	windows.CoTaskMemFree(unsafe.Pointer(_message))
+	return message, nil
 }
 
 // ICoreWebView2CreateCoreWebView2EnvironmentCompletedHandler
@@ -381,7 +671,7 @@ func newICoreWebView2WebMessageReceivedEventHandler(impl iCoreWebView2WebMessage
 
 type iCoreWebView2PermissionRequestedEventHandlerImpl interface {
 	_IUnknownImpl
-	PermissionRequested(sender *ICoreWebView2, args *iCoreWebView2PermissionRequestedEventArgs) uintptr
+	PermissionRequested(sender *ICoreWebView2, args *ICoreWebView2PermissionRequestedEventArgs) uintptr
 }
 
 type iCoreWebView2PermissionRequestedEventHandlerVtbl struct {
@@ -406,7 +696,7 @@ func _ICoreWebView2PermissionRequestedEventHandlerIUnknownRelease(this *iCoreWeb
 	return this.impl.Release()
 }
 
-func _ICoreWebView2PermissionRequestedEventHandlerInvoke(this *iCoreWebView2PermissionRequestedEventHandler, sender *ICoreWebView2, args *iCoreWebView2PermissionRequestedEventArgs) uintptr {
+func _ICoreWebView2PermissionRequestedEventHandlerInvoke(this *iCoreWebView2PermissionRequestedEventHandler, sender *ICoreWebView2, args *ICoreWebView2PermissionRequestedEventArgs) uintptr {
 	return this.impl.PermissionRequested(sender, args)
 }
 
@@ -443,6 +733,24 @@ func (i *ICoreWebView2) AddWebResourceRequestedFilter(uri string, resourceContex
 	}
 	return nil
 }
+
+func (i *ICoreWebView2) RemoveWebResourceRequestedFilter(uri string, resourceContext COREWEBVIEW2_WEB_RESOURCE_CONTEXT) error {
+	var err error
+	_uri, err := windows.UTF16PtrFromString(uri)
+	if err != nil {
+		return err
+	}
+	_, _, err = i.vtbl.RemoveWebResourceRequestedFilter.Call(
+		uintptr(unsafe.Pointer(i)),
+		uintptr(unsafe.Pointer(_uri)),
+		uintptr(resourceContext),
+	)
+	if err != windows.ERROR_SUCCESS {
+		return err
+	}
+	return nil
+}
+
 func (i *ICoreWebView2) AddNavigationCompleted(eventHandler *ICoreWebView2NavigationCompletedEventHandler, token *_EventRegistrationToken) error {
 	var err error
 	_, _, err = i.vtbl.AddNavigationCompleted.Call(
@@ -0,0 +1,69 @@
+//go:build windows
+// +build windows
+
+package edge
+
+import (
+	"errors"
+	"sync/atomic"
+	"syscall"
+	"unsafe"
+)
+
+// SharedEnvironment is a WebView2 environment that several Chromiums made
+// with NewChromiumWithEnvironment share, so that they run in one browser
+// process and the environment is only created once.
+type SharedEnvironment struct {
+	environment  *ICoreWebView2Environment
+	envCompleted *iCoreWebView2CreateCoreWebView2EnvironmentCompletedHandler
+	envOptions   *iCoreWebView2EnvironmentOptions
+	res          uintptr
+	done         uintptr
+}
+
+// NewSharedEnvironment creates an environment set up with opts. Like Embed, it
+// has to be called on the UI thread and dispatches window messages until the
+// environment is ready.
+func NewSharedEnvironment(opts EnvironmentOptions) (*SharedEnvironment, error) {
+	s := &SharedEnvironment{}
+	s.envCompleted = newICoreWebView2CreateCoreWebView2EnvironmentCompletedHandler(s)
+
+	dataPath, err := userDataPath(opts.UserDataFolder)
+	if err != nil {
+		return nil, err
+	}
+	s.envOptions, err = createEnvironment(opts, dataPath, s.envCompleted)
+	if err != nil {
+		return nil, err
+	}
+	pumpUntil(&s.done)
+	if int64(s.res) < 0 {
+		return nil, syscall.Errno(s.res)
+	}
+	if s.environment == nil {
+		return nil, errors.New("message loop terminated")
+	}
+	return s, nil
+}
+
+func (s *SharedEnvironment) QueryInterface(_, _ uintptr) uintptr {
+	return 0
+}
+
+func (s *SharedEnvironment) AddRef() uintptr {
+	return 1
+}
+
+func (s *SharedEnvironment) Release() uintptr {
+	return 1
+}
+
+func (s *SharedEnvironment) EnvironmentCompleted(res uintptr, env *ICoreWebView2Environment) uintptr {
+	s.res = res
+	if int64(res) >= 0 {
+		addRef(unsafe.Pointer(env))
+		s.environment = env
+	}
+	atomic.StoreUintptr(&s.done, 1)
+	return 0
+}
@@ -4,20 +4,35 @@
 package webview2
 
 import (
+	"bytes"
+	"context"
+	"crypto/rand"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"html"
+	"io"
 	"log"
+	"math"
+	"net/http"
 	"os"
+	"path/filepath"
 	"reflect"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"syscall"
+	"time"
+	"unicode/utf16"
 	"unsafe"
 
 	"github.com/project-vrcat/go-webview2/internal/w32"
 	"github.com/project-vrcat/go-webview2/pkg/edge"
+	"github.com/project-vrcat/go-webview2/webviewloader"
 
 	"golang.org/x/sys/windows"
+	"golang.org/x/sys/windows/registry"
 )
 
 var (
@@ -25,6 +40,12 @@ var (
 	windowContextSync sync.RWMutex
 )
 
+var (
+	devToolsHooks     = map[uintptr]*WebView{}
+	devToolsHooksSync sync.Mutex
+	devToolsEventProc = windows.NewCallback(devToolsEvent)
+)
+
 func getWindowContext(wnd uintptr) interface{} {
 	windowContextSync.RLock()
 	defer windowContextSync.RUnlock()
@@ -45,36 +66,262 @@ type browser interface {
 	Eval(script string)
 }
 
+// ErrWebViewNotReady is returned by calls that need a document to have been
+// loaded when no navigation has completed yet.
+var ErrWebViewNotReady = errors.New("webview not ready")
+
+// ErrDestroyed is passed to pending EvalAsync callbacks when the window is
+// destroyed before their script has finished.
+var ErrDestroyed = errors.New("webview destroyed")
+
+// ErrDispatchTimeout is returned by DispatchSyncContext when its context is
+// done before the dispatched function has run.
+var ErrDispatchTimeout = errors.New("dispatch timeout")
+
+// ErrDispatchDeadlineExceeded is returned by DispatchWithDeadline when the UI
+// thread did not get to the function before the deadline.
+var ErrDispatchDeadlineExceeded = errors.New("dispatch deadline exceeded")
+
+// ErrQueueFull is returned by DispatchContext when the dispatch queue stayed
+// full until its context was done.
+var ErrQueueFull = errors.New("dispatch queue full")
+
+// ErrElementNotFound is returned when no element of the page matches a CSS
+// selector.
+var ErrElementNotFound = errors.New("element not found")
+
+// ErrTitleTooLong is returned by SetTitle for titles longer than the 32767
+// UTF-16 code units a window title can hold.
+var ErrTitleTooLong = errors.New("title too long")
+
+// maxTitleLength is the longest window title, in UTF-16 code units.
+const maxTitleLength = 32767
+
+// ErrRuntimeNotInstalled is returned by GetWebView2RuntimeVersion when no
+// WebView2 runtime is installed.
+var ErrRuntimeNotInstalled = errors.New("webview2 runtime not installed")
+
+// hresultFileNotFound is HRESULT_FROM_WIN32(ERROR_FILE_NOT_FOUND), which the
+// loader reports when it finds no runtime.
+const hresultFileNotFound = syscall.Errno(0x80070002)
+
+// GetWebView2RuntimeVersion returns the version of the installed WebView2
+// runtime, such as "109.0.1518.78".
+func GetWebView2RuntimeVersion() (string, error) {
+	version, err := webviewloader.GetAvailableCoreWebView2BrowserVersionString("")
+	if err == hresultFileNotFound || (err == nil && version == "") {
+		return "", ErrRuntimeNotInstalled
+	}
+	if err != nil {
+		return "", err
+	}
+	return version, nil
+}
+
 type WebView struct {
-	HWND       uintptr
-	mainthread uintptr
-	Browser    *edge.Chromium
-	maxsz      w32.Point
-	minsz      w32.Point
-	m          sync.Mutex
-	bindings   map[string]interface{}
-	dispatchq  []func()
+	// AutoFollowTitle makes the window title follow the document title.
+	AutoFollowTitle bool
+
+	HWND                   uintptr
+	mainthread             uintptr
+	Browser                *edge.Chromium
+	maxsz                  w32.Point
+	minsz                  w32.Point
+	m                      sync.Mutex
+	bindings               map[string]binding
+	bindingScripts         map[string]string
+	dispatchq              []*func()
+	evalSeq                int
+	pendingEvals           map[int]func(string, error)
+	title                  string
+	canGoBack              bool
+	canGoForward           bool
+	onHistoryChanged       func(canGoBack, canGoForward bool)
+	initScripts            map[string]string
+	injectedCSS            map[string]string
+	onZoomFactorChanged    func(factor float64)
+	findMatchCount         int
+	navigated              bool
+	onWebMessageReceived   func(source, message string)
+	onNavigationStarted    func(url string, isRedirect bool) bool
+	onNavigationCompleted  func(url string, success bool, httpStatusCode int32)
+	onTitleChanged         func(title string)
+	onWindowCloseRequested func() bool
+	onNewWindowRequested   func(url string) *WebView
+	onPermissionRequested  func(origin, kind string, respond func(PermissionState))
+	onScriptDialogOpening  func(kind DialogKind, text, defaultText string) (string, bool)
+	onBeforeUnload         func(url string) bool
+	navigationFilter       func(url string) bool
+	onNavigationBlocked    func(url string)
+	httpsOnly              bool
+	onHTTPSBlocked         func(url string)
+	customSchemes          map[string]struct{}
+	csp                    string
+	cspScriptID            string
+	onBasicAuth            func(uri, realm string) (string, string, bool)
+	onCertificateError     func(url string, errorStatus int32) bool
+	onContextMenuRequested func(x, y int, items []ContextMenuItem) []ContextMenuItem
+	contextMenuActions     map[int32]func()
+	fullscreen             bool
+	savedStyle             uintptr
+	savedRect              w32.Rect
+	onFullscreenChanged    func(isFullscreen bool)
+	onProcessFailed        func(kind ProcessFailedKind, exitCode int32)
+	tempDataFolder         string
+	schemeHandlers         map[string]func(*SchemeRequest) *SchemeResponse
+	resourceInterceptors   []resourceInterceptor
+	onResponseReceived     func(url string, statusCode int, headers map[string]string)
+	defaultUserAgent       string
+	devToolsHook           uintptr
+	devToolsOpening        int
+	devToolsWindows        map[uintptr]struct{}
+	onPrintCompleted       func(success bool)
+	onDownloadStarted      func(d *DownloadOperation)
+	downloads              map[*edge.ICoreWebView2DownloadOperation]*DownloadOperation
+	onConsoleMessage       func(level ConsoleLevel, message, source string, line int)
+	consoleSubscribed      bool
+	runtimeEnabled         bool
+	onScriptError          func(url string, line, column int, errorMessage string)
+	onPromiseRejection     func(reason string)
+	exceptionsSubscribed   bool
+	onAudioStateChanged    func(audible, muted bool)
+	frameless              bool
+	framelessStyle         uintptr
+	dragRects              []DragRect
+	dragScript             bool
+	onWindowResized        func(clientWidth, clientHeight int)
+	onWindowMoved          func(x, y int)
+	onFocusChanged         func(focused bool)
+	onDPIChanged           func(dpi uint32, suggestedRect w32.Rect)
+	onAccentColorChanged   func(r, g, b uint8)
+	accentColor            uint32
+	onColorSchemeChanged   func(isDark bool)
+	darkMode               bool
+	middleware             []BindingMiddleware
+	onBindingPanic         func(name string, panicValue interface{})
+	rpcTimeout             time.Duration
+	dispatchMax            int
+	dispatchRoom           chan struct{}
+}
+
+// resourceInterceptor is a handler set with OnWebResourceRequested.
+type resourceInterceptor struct {
+	filter  string
+	handler func(*ResourceRequest) *ResourceRequest
+}
+
+// New creates a new webview in a new window configured by opts.
+func New(opts ...WebViewOption) *WebView {
+	return newWebView(newWebViewConfig(opts))
+}
+
+// NewWindow creates a new webview in a new window owned by window, as with
+// WithParentWindow.
+func NewWindow(window unsafe.Pointer, opts ...WebViewOption) *WebView {
+	return New(append(opts, WithParentWindow(window))...)
+}
+
+// NewCompat creates a new webview in a new window.
+//
+// Deprecated: use New with WithDebug and WithUserDataFolder.
+func NewCompat(debug bool, userDataFolder ...string) *WebView {
+	return NewWindowCompat(debug, nil, userDataFolder...)
+}
+
+// NewWindowCompat creates a new webview using an existing window.
+//
+// Deprecated: use NewWindow with WithDebug and WithUserDataFolder.
+func NewWindowCompat(debug bool, window unsafe.Pointer, userDataFolder ...string) *WebView {
+	return newWebView(compatConfig(debug, window, userDataFolder))
 }
 
-// New creates a new webview in a new window.
-func New(debug bool, userDataFolder ...string) *WebView {
-	return NewWindow(debug, nil, userDataFolder...)
+func compatConfig(debug bool, window unsafe.Pointer, userDataFolder []string) *webViewConfig {
+	opts := []WebViewOption{WithDebug(debug), WithParentWindow(window)}
+	if len(userDataFolder) > 0 {
+		opts = append(opts, WithUserDataFolder(userDataFolder[0]))
+	}
+	return newWebViewConfig(opts)
 }
 
-// NewWindow creates a new webview using an existing window.
-func NewWindow(debug bool, window unsafe.Pointer, userDataFolder ...string) *WebView {
+func newWebView(config *webViewConfig) *WebView {
 	w := &WebView{}
-	w.bindings = map[string]interface{}{}
+	w.bindings = map[string]binding{}
+	w.bindingScripts = map[string]string{}
+	w.initScripts = map[string]string{}
+	w.injectedCSS = map[string]string{}
+	w.customSchemes = map[string]struct{}{}
+	for _, registration := range config.envOptions.CustomSchemeRegistrations {
+		w.customSchemes[strings.ToLower(registration.SchemeName)] = struct{}{}
+	}
+	w.pendingEvals = map[int]func(string, error){}
+	w.contextMenuActions = map[int32]func(){}
+	w.schemeHandlers = map[string]func(*SchemeRequest) *SchemeResponse{}
+	w.devToolsWindows = map[uintptr]struct{}{}
+	w.downloads = map[*edge.ICoreWebView2DownloadOperation]*DownloadOperation{}
 
-	chromium := edge.NewChromium()
+	var chromium *edge.Chromium
+	if config.environment != nil {
+		chromium = edge.NewChromiumWithEnvironment(config.environment)
+	} else {
+		chromium = edge.NewChromiumWithOptions(config.envOptions)
+	}
 	chromium.MessageCallback = w.msgcb
-	chromium.Debug = debug
+	chromium.WebMessageReceivedCallback = w.webMessageReceived
+	chromium.NavigationStartingCallback = w.navigationStarting
+	chromium.FrameNavigationStartingCallback = w.frameNavigationStarting
+	chromium.DocumentTitleChangedCallback = w.documentTitleChanged
+	chromium.HistoryChangedCallback = w.historyChanged
+	chromium.ZoomFactorChangedCallback = w.zoomFactorChanged
+	chromium.NavigationCompletedCallback = w.navigationCompleted
+	chromium.WindowCloseRequestedCallback = w.windowCloseRequested
+	chromium.NewWindowRequestedCallback = w.newWindowRequested
+	chromium.PermissionRequestedCallback = w.permissionRequested
+	chromium.ScriptDialogOpeningCallback = w.scriptDialogOpening
+	chromium.BasicAuthenticationRequestedCallback = w.basicAuthenticationRequested
+	chromium.ServerCertificateErrorDetectedCallback = w.serverCertificateErrorDetected
+	chromium.ContextMenuRequestedCallback = w.contextMenuRequested
+	chromium.CustomItemSelectedCallback = w.customItemSelected
+	chromium.ContainsFullScreenElementChangedCallback = w.containsFullScreenElementChanged
+	chromium.ProcessFailedCallback = w.processFailed
+	chromium.WebResourceRequestedCallback = w.webResourceRequested
+	chromium.WebResourceResponseReceivedCallback = w.webResourceResponseReceived
+	chromium.DownloadStartingCallback = w.downloadStarting
+	chromium.DownloadStateChangedCallback = w.downloadStateChanged
+	chromium.IsMutedChangedCallback = w.audioStateChanged
+	chromium.IsDocumentPlayingAudioChangedCallback = w.audioStateChanged
+	chromium.Debug = config.debug
+	chromium.InPrivate = config.incognito
+	if config.incognito && config.userDataFolder == "" && config.environment == nil {
+		folder, err := os.MkdirTemp("", "webview2-")
+		if err != nil {
+			log.Printf("WithIncognitoProfile: %v", err)
+			return nil
+		}
+		w.tempDataFolder = folder
+		config.userDataFolder = folder
+	}
 
 	w.Browser = chromium
 	w.mainthread, _, _ = w32.Kernel32GetCurrentThreadID.Call()
-	if !w.Create(debug, window, userDataFolder...) {
+	if !w.create(config) {
 		return nil
 	}
+	if config.background != nil {
+		if err := w.Browser.PutDefaultBackgroundColor(*config.background); err != nil {
+			log.Printf("WithBackgroundColor: %v", err)
+		}
+	}
+	if config.title != "" {
+		if err := w.SetTitle(config.title); err != nil {
+			log.Printf("WithTitle: %v", err)
+		}
+	}
+	if config.width > 0 && config.height > 0 {
+		w.SetSize(config.width, config.height, config.hint)
+	}
+	if config.initialURL != "" {
+		w.Navigate(config.initialURL)
+	}
 	return w
 }
 
@@ -86,52 +333,232 @@ type rpcMessage struct {
 
 func jsString(v interface{}) string { b, _ := json.Marshal(v); return string(b) }
 
-func (w *WebView) msgcb(msg string) {
+// parseRPC decodes msg as a call from a bound function. Anything else posted
+// by the page is left to the OnWebMessageReceived handler.
+func parseRPC(msg string) (rpcMessage, bool) {
 	d := rpcMessage{}
-	if err := json.Unmarshal([]byte(msg), &d); err != nil {
-		log.Printf("invalid RPC message: %v", err)
+	if err := json.Unmarshal([]byte(msg), &d); err != nil || d.Method == "" {
+		return d, false
+	}
+	return d, true
+}
+
+func (w *WebView) msgcb(msg string) {
+	d, ok := parseRPC(msg)
+	if !ok {
+		w.m.Lock()
+		handled := w.onWebMessageReceived != nil
+		w.m.Unlock()
+		if !handled {
+			log.Printf("invalid RPC message: %s", msg)
+		}
 		return
 	}
+	if d.Method == dragMethod {
+		w.startDrag()
+		return
+	}
+
+	w.m.Lock()
+	b, ok := w.bindings[d.Method]
+	rpcTimeout := w.rpcTimeout
+	w.m.Unlock()
+	if !ok {
+		w.reply(d.ID, nil, nil)
+		return
+	}
+	if b.offThread() || rpcTimeout > 0 {
+		go func() {
+			res, err := w.callbinding(b, d)
+			w.reply(d.ID, res, err)
+		}()
+		return
+	}
+	res, err := w.callbinding(b, d)
+	w.reply(d.ID, res, err)
+}
 
-	id := strconv.Itoa(d.ID)
-	if res, err := w.callbinding(d); err != nil {
+// reply settles the promise of the JavaScript call id with res or err. The
+// call may already have been rejected by Unbind, in which case nothing is
+// left to settle.
+func (w *WebView) reply(id int, res interface{}, err error) {
+	seq := strconv.Itoa(id)
+	settle := func(js string) {
 		w.Dispatch(func() {
-			w.Eval("window._rpc[" + id + "].reject(" + jsString(err.Error()) + "); window._rpc[" + id + "] = undefined")
+			w.Eval("if (window._rpc && window._rpc[" + seq + "]) { window._rpc[" + seq + "]." + js + "; window._rpc[" + seq + "] = undefined }")
 		})
+	}
+	if err != nil {
+		settle("reject(" + jsString(err.Error()) + ")")
 	} else if b, err := json.Marshal(res); err != nil {
-		w.Dispatch(func() {
-			w.Eval("window._rpc[" + id + "].reject(" + jsString(err.Error()) + "); window._rpc[" + id + "] = undefined")
-		})
+		settle("reject(" + jsString(err.Error()) + ")")
 	} else {
-		w.Dispatch(func() {
-			w.Eval("window._rpc[" + id + "].resolve(" + string(b) + "); window._rpc[" + id + "] = undefined")
-		})
+		settle("resolve(" + string(b) + ")")
+	}
+}
+
+func (w *WebView) webMessageReceived(source, message string) {
+	if _, ok := parseRPC(message); ok {
+		return
+	}
+	w.m.Lock()
+	handler := w.onWebMessageReceived
+	w.m.Unlock()
+	if handler != nil {
+		w.Dispatch(func() { handler(source, message) })
 	}
 }
 
-func (w *WebView) callbinding(d rpcMessage) (interface{}, error) {
+// OnWebMessageReceived sets a handler for messages the page sends with
+// window.chrome.webview.postMessage. source is the URI of the sending
+// document; messages that are not strings arrive JSON encoded. Calls made by
+// bound functions are not passed on. It replaces any previous handler.
+func (w *WebView) OnWebMessageReceived(handler func(source, message string)) {
 	w.m.Lock()
-	f, ok := w.bindings[d.Method]
+	w.onWebMessageReceived = handler
 	w.m.Unlock()
+}
+
+// binding is a Go function bound with Bind or one of its variants.
+type binding struct {
+	f interface{}
+
+	// ctx is the context of a binding made with BindWithContext.
+	ctx context.Context
+
+	// async is set for bindings made with BindAsync, whose function returns
+	// a channel that delivers the result.
+	async bool
+
+	// timeout is the time limit of a binding made with BindWithTimeout.
+	timeout time.Duration
+}
+
+// offThread reports whether calls to b run on their own goroutine, so that
+// they can be given up or wait without blocking the UI thread.
+func (b binding) offThread() bool {
+	return b.ctx != nil || b.async || b.timeout > 0
+}
+
+// call calls the function of b with params. For BindAsync bindings it then
+// waits for the value of the returned channel.
+func (b binding) call(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	res, err := callFunc(ctx, b.f, params)
+	if err != nil || !b.async {
+		return res, err
+	}
+	ch := reflect.ValueOf(res)
+	if ch.IsNil() {
+		return nil, errors.New("binding returned a nil channel")
+	}
+	v, ok := ch.Recv()
 	if !ok {
-		return nil, nil
+		return nil, errors.New("binding channel closed without a value")
+	}
+	if err, isErr := v.Interface().(error); isErr {
+		return nil, err
+	}
+	return v.Interface(), nil
+}
+
+// errBindingCancelled rejects calls to a binding whose context is done.
+var errBindingCancelled = errors.New("binding context cancelled")
+
+// errRPCTimeout rejects calls that take longer than the SetGlobalRPCTimeout.
+var errRPCTimeout = errors.New("rpc timeout")
+
+var contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+
+// callbinding calls the function of b with the arguments of d. For bindings
+// with a context it returns as soon as the context is done, leaving the
+// function to finish on its own.
+func (w *WebView) callbinding(b binding, d rpcMessage) (interface{}, error) {
+	w.m.Lock()
+	middleware := w.middleware
+	rpcTimeout := w.rpcTimeout
+	w.m.Unlock()
+	timeout := b.timeout
+	timeoutErr := fmt.Errorf("binding timeout after %dms", timeout.Milliseconds())
+	if rpcTimeout > 0 && (timeout <= 0 || rpcTimeout < timeout) {
+		timeout, timeoutErr = rpcTimeout, errRPCTimeout
+	}
+	call := func(ctx context.Context) (res interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				res, err = nil, fmt.Errorf("binding %s panicked: %v", d.Method, r)
+				w.bindingPanicked(d.Method, r)
+			}
+		}()
+		next := func(params []json.RawMessage) (interface{}, error) {
+			return b.call(ctx, params)
+		}
+		for i := len(middleware) - 1; i >= 0; i-- {
+			mw, inner := middleware[i], next
+			next = func(params []json.RawMessage) (interface{}, error) {
+				return mw(d.Method, params, inner)
+			}
+		}
+		return next(d.Params)
+	}
+
+	if b.ctx == nil && timeout <= 0 {
+		return call(nil)
+	}
+	ctx := b.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if ctx.Err() != nil {
+		return nil, errBindingCancelled
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	type result struct {
+		res interface{}
+		err error
 	}
+	ch := make(chan result, 1)
+	go func() {
+		res, err := call(ctx)
+		ch <- result{res, err}
+	}()
+	select {
+	case r := <-ch:
+		return r.res, r.err
+	case <-ctx.Done():
+		if b.ctx != nil && b.ctx.Err() != nil {
+			return nil, errBindingCancelled
+		}
+		return nil, timeoutErr
+	}
+}
 
+// callFunc calls f with params decoded from JSON. If ctx is not nil and the
+// first parameter of f is a context.Context, ctx is passed in its place.
+func callFunc(ctx context.Context, f interface{}, params []json.RawMessage) (interface{}, error) {
 	v := reflect.ValueOf(f)
+	args := []reflect.Value{}
+	first := 0
+	if ctx != nil && v.Type().NumIn() > 0 && v.Type().In(0) == contextType {
+		args = append(args, reflect.ValueOf(ctx))
+		first = 1
+	}
 	isVariadic := v.Type().IsVariadic()
-	numIn := v.Type().NumIn()
-	if (isVariadic && len(d.Params) < numIn-1) || (!isVariadic && len(d.Params) != numIn) {
+	numIn := v.Type().NumIn() - first
+	if (isVariadic && len(params) < numIn-1) || (!isVariadic && len(params) != numIn) {
 		return nil, errors.New("function arguments mismatch")
 	}
-	args := []reflect.Value{}
-	for i := range d.Params {
+	for i := range params {
 		var arg reflect.Value
 		if isVariadic && i >= numIn-1 {
-			arg = reflect.New(v.Type().In(numIn - 1).Elem())
+			arg = reflect.New(v.Type().In(first + numIn - 1).Elem())
 		} else {
-			arg = reflect.New(v.Type().In(i))
+			arg = reflect.New(v.Type().In(first + i))
 		}
-		if err := json.Unmarshal(d.Params[i], arg.Interface()); err != nil {
+		if err := json.Unmarshal(params[i], arg.Interface()); err != nil {
 			return nil, err
 		}
 		args = append(args, arg.Elem())
@@ -169,155 +596,3043 @@ func (w *WebView) callbinding(d rpcMessage) (interface{}, error) {
 	}
 }
 
-func wndproc(hwnd, msg, wp, lp uintptr) uintptr {
-	if w, ok := getWindowContext(hwnd).(*WebView); ok {
-		switch msg {
-		case w32.WMSize:
-			w.Browser.Resize()
-		case w32.WMClose:
-			w32.User32DestroyWindow.Call(hwnd)
-		case w32.WMDestroy:
-			w.Terminate()
-		case w32.WMGetMinMaxInfo:
-			lpmmi := (*w32.MinMaxInfo)(unsafe.Pointer(lp))
-			if w.maxsz.X > 0 && w.maxsz.Y > 0 {
-				lpmmi.PtMaxSize = w.maxsz
-				lpmmi.PtMaxTrackSize = w.maxsz
-			}
-			if w.minsz.X > 0 && w.minsz.Y > 0 {
-				lpmmi.PtMinTrackSize = w.minsz
-			}
-		default:
-			r, _, _ := w32.User32DefWindowProcW.Call(hwnd, msg, wp, lp)
-			return r
+func (w *WebView) navigationStarting(sender *edge.ICoreWebView2, args *edge.ICoreWebView2NavigationStartingEventArgs) {
+	if w.filterNavigation(args) {
+		return
+	}
+
+	w.m.Lock()
+	w.title = ""
+	handler := w.onNavigationStarted
+	w.m.Unlock()
+
+	if handler != nil {
+		uri, _ := args.GetUri()
+		isRedirect, _ := args.GetIsRedirected()
+		if !handler(uri, isRedirect) {
+			args.PutCancel(true)
 		}
-		return 0
 	}
-	r, _, _ := w32.User32DefWindowProcW.Call(hwnd, msg, wp, lp)
-	return r
 }
 
-func (w *WebView) Create(debug bool, window unsafe.Pointer, userDataFolder ...string) bool {
-	var hinstance windows.Handle
-	windows.GetModuleHandleEx(0, nil, &hinstance)
+// OnNavigationStarted sets a handler that is called on the UI thread before
+// the webview navigates to url. Returning false cancels the navigation. It
+// replaces any previous handler.
+func (w *WebView) OnNavigationStarted(handler func(url string, isRedirect bool) bool) {
+	w.m.Lock()
+	w.onNavigationStarted = handler
+	w.m.Unlock()
+}
 
-	icon := w32.ExtractIcon(os.Args[0], 0)
+func (w *WebView) frameNavigationStarting(sender *edge.ICoreWebView2, args *edge.ICoreWebView2NavigationStartingEventArgs) {
+	w.filterNavigation(args)
+}
 
-	className, _ := windows.UTF16PtrFromString("webview")
-	wc := w32.WndClassExW{
-		CbSize:        uint32(unsafe.Sizeof(w32.WndClassExW{})),
-		HInstance:     hinstance,
-		LpszClassName: className,
-		HIcon:         windows.Handle(icon),
-		HIconSm:       windows.Handle(icon),
-		LpfnWndProc:   windows.NewCallback(wndproc),
+// filterNavigation cancels the navigation of args if HTTPS-only mode or the
+// navigation filter rejects its URL, and reports whether it did.
+func (w *WebView) filterNavigation(args *edge.ICoreWebView2NavigationStartingEventArgs) bool {
+	w.m.Lock()
+	filter := w.navigationFilter
+	blocked := w.onNavigationBlocked
+	httpsOnly := w.httpsOnly
+	httpsBlocked := w.onHTTPSBlocked
+	w.m.Unlock()
+	if filter == nil && !httpsOnly {
+		return false
 	}
-	w32.User32RegisterClassExW.Call(uintptr(unsafe.Pointer(&wc)))
-
-	windowName, _ := windows.UTF16PtrFromString("")
-	w.HWND, _, _ = w32.User32CreateWindowExW.Call(
-		0,
-		uintptr(unsafe.Pointer(className)),
-		uintptr(unsafe.Pointer(windowName)),
-		0xCF0000,   // WS_OVERLAPPEDWINDOW
-		0x80000000, // CW_USEDEFAULT
-		0x80000000, // CW_USEDEFAULT
-		640,
-		480,
-		0,
-		0,
-		uintptr(hinstance),
-		0,
-	)
-	setWindowContext(w.HWND, w)
 
-	w32.User32ShowWindow.Call(w.HWND, w32.SWShow)
-	w32.User32UpdateWindow.Call(w.HWND)
-	w32.User32SetFocus.Call(w.HWND)
-
-	if !w.Browser.Embed(w.HWND, userDataFolder...) {
+	uri, _ := args.GetUri()
+	if httpsOnly && !w.isSecureURL(uri) {
+		args.PutCancel(true)
+		if httpsBlocked != nil {
+			httpsBlocked(uri)
+		}
+		return true
+	}
+	if filter == nil || filter(uri) {
 		return false
 	}
-	w.Browser.Resize()
+	args.PutCancel(true)
+	if blocked != nil {
+		blocked(uri)
+	}
 	return true
 }
 
-func (w *WebView) Destroy() {
+// isSecureURL reports whether HTTPS-only mode allows navigating to uri: https,
+// about and data URLs and those of custom schemes.
+func (w *WebView) isSecureURL(uri string) bool {
+	i := strings.IndexByte(uri, ':')
+	if i < 0 {
+		return false
+	}
+	scheme := strings.ToLower(uri[:i])
+	switch scheme {
+	case "https", "about", "data":
+		return true
+	}
+	w.m.Lock()
+	defer w.m.Unlock()
+	_, registered := w.customSchemes[scheme]
+	_, handled := w.schemeHandlers[scheme]
+	return registered || handled
 }
 
-func (w *WebView) Run() {
-	var msg w32.Msg
-	for {
-		w32.User32GetMessageW.Call(
-			uintptr(unsafe.Pointer(&msg)),
-			0,
-			0,
-			0,
-		)
-		if msg.Message == w32.WMApp {
-			w.m.Lock()
-			q := append([]func(){}, w.dispatchq...)
-			w.dispatchq = []func(){}
-			w.m.Unlock()
-			for _, v := range q {
-				v()
-			}
-		} else if msg.Message == w32.WMQuit {
-			return
-		}
-		w32.User32TranslateMessage.Call(uintptr(unsafe.Pointer(&msg)))
-		w32.User32DispatchMessageW.Call(uintptr(unsafe.Pointer(&msg)))
-	}
+// SetHTTPSOnlyMode blocks navigations of the webview and its frames to URLs
+// other than https, about, data and those of custom schemes while enabled,
+// so that no page is loaded over plain HTTP. Blocked navigations are reported
+// to the OnHTTPSNavigationBlocked handler. HTTPS-only mode applies before the
+// SetNavigationFilter filter.
+func (w *WebView) SetHTTPSOnlyMode(enabled bool) {
+	w.m.Lock()
+	w.httpsOnly = enabled
+	w.m.Unlock()
 }
 
-func (w *WebView) Terminate() {
-	w32.User32PostQuitMessage.Call(0)
+// OnHTTPSNavigationBlocked sets a handler that is called on the UI thread
+// after HTTPS-only mode cancelled a navigation to url. It replaces any
+// previous handler.
+func (w *WebView) OnHTTPSNavigationBlocked(handler func(url string)) {
+	w.m.Lock()
+	w.onHTTPSBlocked = handler
+	w.m.Unlock()
 }
 
-func (w *WebView) Window() unsafe.Pointer {
-	return unsafe.Pointer(w.HWND)
+// SetNavigationFilter sets a filter that is called on the UI thread before
+// the webview or any of its frames navigates to url. Returning false cancels
+// the navigation and calls the OnNavigationBlocked handler; the
+// OnNavigationStarted handler is only called for navigations the filter
+// lets through. It replaces any previous filter; nil allows all navigations.
+func (w *WebView) SetNavigationFilter(filter func(url string) bool) {
+	w.m.Lock()
+	w.navigationFilter = filter
+	w.m.Unlock()
 }
 
-func (w *WebView) Navigate(url string) {
-	w.Browser.Navigate(url)
+// OnNavigationBlocked sets a handler that is called on the UI thread after
+// the SetNavigationFilter filter cancelled a navigation to url. It replaces
+// any previous handler.
+func (w *WebView) OnNavigationBlocked(handler func(url string)) {
+	w.m.Lock()
+	w.onNavigationBlocked = handler
+	w.m.Unlock()
 }
 
-func (w *WebView) SetTitle(title string) {
-	_title, err := windows.UTF16FromString(title)
-	if err != nil {
-		_title, _ = windows.UTF16FromString("")
+func (w *WebView) navigationCompleted(sender *edge.ICoreWebView2, args *edge.ICoreWebView2NavigationCompletedEventArgs) {
+	w.m.Lock()
+	w.navigated = true
+	handler := w.onNavigationCompleted
+	w.m.Unlock()
+
+	if handler != nil {
+		success, _ := args.GetIsSuccess()
+		httpStatusCode, _ := args.GetHttpStatusCode()
+		w.Dispatch(func() {
+			handler(w.GetCurrentURL(), success, httpStatusCode)
+		})
 	}
-	w32.User32SetWindowTextW.Call(w.HWND, uintptr(unsafe.Pointer(&_title[0])))
 }
 
-func (w *WebView) SetSize(width int, height int, hints Hint) {
-	index := w32.GWLStyle
-	style, _, _ := w32.User32GetWindowLongPtrW.Call(w.HWND, uintptr(index))
-	if hints == HintFixed {
-		style &^= (w32.WSThickFrame | w32.WSMaximizeBox)
-	} else {
-		style |= (w32.WSThickFrame | w32.WSMaximizeBox)
+// OnNavigationCompleted sets a handler that is called on the UI thread once a
+// navigation has finished, successfully or not. httpStatusCode is 0 if the
+// installed runtime does not report it. It replaces any previous handler.
+func (w *WebView) OnNavigationCompleted(handler func(url string, success bool, httpStatusCode int32)) {
+	w.m.Lock()
+	w.onNavigationCompleted = handler
+	w.m.Unlock()
+}
+
+func (w *WebView) documentTitleChanged(sender *edge.ICoreWebView2) {
+	title, err := sender.GetDocumentTitle()
+	if err != nil {
+		log.Printf("DocumentTitleChanged: %v", err)
+		return
 	}
-	w32.User32SetWindowLongPtrW.Call(w.HWND, uintptr(index), style)
+	w.m.Lock()
+	w.title = title
+	handler := w.onTitleChanged
+	w.m.Unlock()
 
-	if hints == HintMax {
-		w.maxsz.X = int32(width)
-		w.maxsz.Y = int32(height)
-	} else if hints == HintMin {
-		w.minsz.X = int32(width)
-		w.minsz.Y = int32(height)
-	} else if hints == HintCenter {
-		scrWidth, _, _ := w32.User32GetSystemMetrics.Call(w32.SystemMetricsCxScreen)
-		scrHeight, _, _ := w32.User32GetSystemMetrics.Call(w32.SystemMetricsCyScreen)
-		rect := new(w32.Rect)
-		ret, _, _ := syscall.Syscall(w32.User32GetWindowRect.Addr(), 2,
-			w.HWND,
-			uintptr(unsafe.Pointer(rect)),
-			0)
-		if ret == 0 {
-			return
+	w.Dispatch(func() {
+		if w.AutoFollowTitle {
+			if err := w.SetTitle(title); err != nil {
+				log.Printf("AutoFollowTitle: %v", err)
+			}
 		}
-		rect.Left = int32((int(scrWidth) - width) / 2)
+		if handler != nil {
+			handler(title)
+		}
+	})
+}
+
+// OnTitleChanged sets a handler that is called on the UI thread whenever the
+// document title changes. It replaces any previous handler.
+func (w *WebView) OnTitleChanged(handler func(title string)) {
+	w.m.Lock()
+	w.onTitleChanged = handler
+	w.m.Unlock()
+}
+
+func (w *WebView) historyChanged(sender *edge.ICoreWebView2) {
+	canGoBack, _ := sender.GetCanGoBack()
+	canGoForward, _ := sender.GetCanGoForward()
+	w.m.Lock()
+	w.canGoBack = canGoBack
+	w.canGoForward = canGoForward
+	handler := w.onHistoryChanged
+	w.m.Unlock()
+	if handler != nil {
+		handler(canGoBack, canGoForward)
+	}
+}
+
+func (w *WebView) zoomFactorChanged(factor float64) {
+	w.m.Lock()
+	handler := w.onZoomFactorChanged
+	w.m.Unlock()
+	if handler != nil {
+		handler(factor)
+	}
+}
+
+func (w *WebView) windowCloseRequested(sender *edge.ICoreWebView2) {
+	w.m.Lock()
+	handler := w.onWindowCloseRequested
+	w.m.Unlock()
+	if handler != nil && handler() {
+		w32.User32DestroyWindow.Call(w.HWND)
+	}
+}
+
+// OnWindowCloseRequested sets a handler that is called on the UI thread when
+// the page calls window.close(). Returning true destroys the window, false
+// keeps it open. Without a handler window.close() is ignored. It replaces any
+// previous handler.
+func (w *WebView) OnWindowCloseRequested(handler func() bool) {
+	w.m.Lock()
+	w.onWindowCloseRequested = handler
+	w.m.Unlock()
+}
+
+func (w *WebView) newWindowRequested(sender *edge.ICoreWebView2, args *edge.ICoreWebView2NewWindowRequestedEventArgs) {
+	w.m.Lock()
+	handler := w.onNewWindowRequested
+	w.m.Unlock()
+	if handler == nil {
+		return
+	}
+
+	uri, _ := args.GetUri()
+	deferral, err := args.GetDeferral()
+	if err != nil {
+		log.Printf("NewWindowRequested: %v", err)
+		return
+	}
+	// Creating the popup runs a nested message loop, so leave the event
+	// handler first and finish through the deferral.
+	args.AddRef()
+	w.Dispatch(func() {
+		defer args.Release()
+		if popup := handler(uri); popup != nil {
+			args.PutNewWindow(popup.Browser.CoreWebView())
+		}
+		args.PutHandled(true)
+		deferral.Complete()
+	})
+}
+
+// OnNewWindowRequested sets a handler that is called on the UI thread when the
+// page tries to open url in a new window, for example with window.open(). The
+// handler may return a new, not yet navigated WebView to show the popup in, or
+// nil to block it. Without a handler, WebView2 opens its own window. It
+// replaces any previous handler.
+func (w *WebView) OnNewWindowRequested(handler func(url string) *WebView) {
+	w.m.Lock()
+	w.onNewWindowRequested = handler
+	w.m.Unlock()
+}
+
+var permissionKinds = map[edge.COREWEBVIEW2_PERMISSION_KIND]string{
+	edge.COREWEBVIEW2_PERMISSION_KIND_CAMERA:                         PermissionKindCamera,
+	edge.COREWEBVIEW2_PERMISSION_KIND_MICROPHONE:                     PermissionKindMicrophone,
+	edge.COREWEBVIEW2_PERMISSION_KIND_GEOLOCATION:                    PermissionKindGeolocation,
+	edge.COREWEBVIEW2_PERMISSION_KIND_NOTIFICATIONS:                  PermissionKindNotifications,
+	edge.COREWEBVIEW2_PERMISSION_KIND_OTHER_SENSORS:                  PermissionKindOtherSensors,
+	edge.COREWEBVIEW2_PERMISSION_KIND_MIDI_SYSTEM_EXCLUSIVE_MESSAGES: PermissionKindMidiSysEx,
+	edge.COREWEBVIEW2_PERMISSION_KIND_CLIPBOARD_READ:                 PermissionKindClipboardRead,
+}
+
+func (w *WebView) permissionRequested(sender *edge.ICoreWebView2, args *edge.ICoreWebView2PermissionRequestedEventArgs) {
+	w.m.Lock()
+	handler := w.onPermissionRequested
+	w.m.Unlock()
+	if handler == nil {
+		return
+	}
+
+	origin, _ := args.GetUri()
+	kind, _ := args.GetPermissionKind()
+	name, ok := permissionKinds[kind]
+	if !ok {
+		name = PermissionKindUnknown
+	}
+	deferral, err := args.GetDeferral()
+	if err != nil {
+		log.Printf("PermissionRequested: %v", err)
+		return
+	}
+	args.AddRef()
+	var once sync.Once
+	handler(origin, name, func(state PermissionState) {
+		once.Do(func() {
+			w.onMainThread(func() {
+				args.PutState(edge.COREWEBVIEW2_PERMISSION_STATE(state))
+				deferral.Complete()
+				args.Release()
+			})
+		})
+	})
+}
+
+// OnPermissionRequested sets a handler that is called on the UI thread when
+// the page at origin asks for a permission of the given kind, one of the
+// PermissionKind constants. Without a handler only clipboard reads are
+// allowed. It replaces any previous handler.
+func (w *WebView) OnPermissionRequested(handler func(origin, kind string) PermissionState) {
+	if handler == nil {
+		w.OnPermissionRequestedAsync(nil)
+		return
+	}
+	w.OnPermissionRequestedAsync(func(origin, kind string, respond func(PermissionState)) {
+		respond(handler(origin, kind))
+	})
+}
+
+// OnPermissionRequestedAsync is like OnPermissionRequested, but the handler
+// answers by calling respond, which may happen later from any goroutine, for
+// example after asking the user. The page waits until respond is called, so
+// the handler must call it exactly once. It replaces any previous handler.
+func (w *WebView) OnPermissionRequestedAsync(handler func(origin, kind string, respond func(PermissionState))) {
+	w.m.Lock()
+	w.onPermissionRequested = handler
+	w.m.Unlock()
+}
+
+func (w *WebView) scriptDialogOpening(sender *edge.ICoreWebView2, args *edge.ICoreWebView2ScriptDialogOpeningEventArgs) {
+	w.m.Lock()
+	handler := w.onScriptDialogOpening
+	beforeUnload := w.onBeforeUnload
+	w.m.Unlock()
+
+	_kind, _ := args.GetKind()
+	kind := DialogKind(_kind)
+	if kind == DialogKindBeforeUnload && beforeUnload != nil {
+		uri, _ := args.GetUri()
+		if beforeUnload(uri) {
+			args.Accept()
+		}
+		return
+	}
+	if handler == nil {
+		// Only OnBeforeUnload is set; leaving args untouched answers the
+		// dialog as if it had been dismissed.
+		return
+	}
+
+	text, _ := args.GetMessage()
+	defaultText, _ := args.GetDefaultText()
+	result, suppress := handler(kind, text, defaultText)
+	if suppress {
+		switch kind {
+		case DialogKindAlert:
+			args.Accept()
+		case DialogKindPrompt:
+			args.PutResultText(result)
+			args.Accept()
+		default:
+			if result != "" {
+				args.Accept()
+			}
+		}
+		return
+	}
+
+	// The message box runs a nested message loop, so leave the event handler
+	// first and finish through the deferral.
+	deferral, err := args.GetDeferral()
+	if err != nil {
+		log.Printf("ScriptDialogOpening: %v", err)
+		return
+	}
+	uri, _ := args.GetUri()
+	args.AddRef()
+	w.Dispatch(func() {
+		defer args.Release()
+		style := uintptr(w32.MBOkCancel | w32.MBIconWarning)
+		if kind == DialogKindAlert {
+			style = w32.MBOk | w32.MBIconWarning
+		}
+		_text, _ := windows.UTF16PtrFromString(text)
+		_caption, _ := windows.UTF16PtrFromString(uri)
+		r, _, _ := w32.User32MessageBoxW.Call(
+			w.HWND,
+			uintptr(unsafe.Pointer(_text)),
+			uintptr(unsafe.Pointer(_caption)),
+			style,
+		)
+		if r == w32.IDOk {
+			if kind == DialogKindPrompt {
+				args.PutResultText(result)
+			}
+			args.Accept()
+		}
+		deferral.Complete()
+	})
+}
+
+// OnScriptDialogOpening sets a handler that is called on the UI thread when
+// the page opens an alert, confirm, prompt or beforeunload dialog with the
+// given text. The handler returns suppress true to answer the dialog itself
+// without showing anything: confirm and beforeunload dialogs are accepted
+// when result is not empty, and prompts return result. Otherwise a native
+// message box shows text, and a prompt confirmed there returns result. It
+// replaces any previous handler; nil brings back the default browser dialogs.
+func (w *WebView) OnScriptDialogOpening(handler func(kind DialogKind, text, defaultText string) (result string, suppress bool)) {
+	w.m.Lock()
+	w.onScriptDialogOpening = handler
+	w.m.Unlock()
+	w.updateScriptDialogs()
+}
+
+// OnBeforeUnload sets a handler that is called on the UI thread when the user
+// is about to leave the page at url, through navigation, reload or closing.
+// It only fires for pages that registered a beforeunload listener asking to
+// confirm leaving. Returning false keeps the user on the page; true lets the
+// navigation go on. It takes precedence over OnScriptDialogOpening for
+// beforeunload dialogs. It replaces any previous handler.
+//
+// WebView2 only reports beforeunload dialogs once its own dialogs are turned
+// off, which turns them off for alert, confirm and prompt too. While
+// OnBeforeUnload is set without OnScriptDialogOpening, those dialogs are
+// therefore not shown at all and answered as if dismissed: confirm returns
+// false and prompt returns null. Set OnScriptDialogOpening as well to handle
+// them.
+func (w *WebView) OnBeforeUnload(handler func(url string) bool) {
+	w.m.Lock()
+	w.onBeforeUnload = handler
+	w.m.Unlock()
+	w.updateScriptDialogs()
+}
+
+// updateScriptDialogs turns the default script dialogs off while a handler
+// needs the ScriptDialogOpening event.
+func (w *WebView) updateScriptDialogs() {
+	w.onMainThread(func() {
+		w.m.Lock()
+		enabled := w.onScriptDialogOpening == nil && w.onBeforeUnload == nil
+		w.m.Unlock()
+		settings, err := w.Browser.GetSettings()
+		if err != nil {
+			log.Printf("updateScriptDialogs: %v", err)
+			return
+		}
+		// WebView2 only raises the event when its own dialogs are off.
+		settings.PutAreDefaultScriptDialogsEnabled(enabled)
+	})
+}
+
+func (w *WebView) basicAuthenticationRequested(sender *edge.ICoreWebView2, args *edge.ICoreWebView2BasicAuthenticationRequestedEventArgs) {
+	w.m.Lock()
+	handler := w.onBasicAuth
+	w.m.Unlock()
+	if handler == nil {
+		return
+	}
+
+	uri, _ := args.GetUri()
+	challenge, _ := args.GetChallenge()
+	deferral, err := args.GetDeferral()
+	if err != nil {
+		log.Printf("BasicAuthenticationRequested: %v", err)
+		return
+	}
+	args.AddRef()
+	go func() {
+		username, password, cancel := handler(uri, challengeRealm(challenge))
+		w.Dispatch(func() {
+			defer args.Release()
+			defer deferral.Complete()
+			if cancel {
+				args.PutCancel(true)
+				return
+			}
+			response, err := args.GetResponse()
+			if err != nil {
+				log.Printf("BasicAuthenticationRequested: %v", err)
+				return
+			}
+			defer response.Release()
+			response.PutUserName(username)
+			response.PutPassword(password)
+		})
+	}()
+}
+
+// challengeRealm returns the realm of a WWW-Authenticate challenge such as
+// `Basic realm="Intranet"`, or an empty string if it names none.
+func challengeRealm(challenge string) string {
+	i := strings.Index(strings.ToLower(challenge), `realm="`)
+	if i < 0 {
+		return ""
+	}
+	realm := challenge[i+len(`realm="`):]
+	if j := strings.IndexByte(realm, '"'); j >= 0 {
+		realm = realm[:j]
+	}
+	return realm
+}
+
+// OnBasicAuthenticationRequested sets a handler that supplies the username
+// and password when a server asks for HTTP basic authentication of uri in
+// realm, instead of the browser prompting the user. Returning cancel true
+// fails the request, as if the user had dismissed the prompt. The handler
+// runs on its own goroutine, so it may block, for example to ask the user,
+// while the request waits. The handler is not called on runtimes too old to
+// report the request, which keep showing their prompt. It replaces any
+// previous handler; nil brings back the prompt.
+func (w *WebView) OnBasicAuthenticationRequested(handler func(uri, realm string) (username, password string, cancel bool)) {
+	w.m.Lock()
+	w.onBasicAuth = handler
+	w.m.Unlock()
+}
+
+func (w *WebView) serverCertificateErrorDetected(sender *edge.ICoreWebView2, args *edge.ICoreWebView2ServerCertificateErrorDetectedEventArgs) {
+	w.m.Lock()
+	handler := w.onCertificateError
+	w.m.Unlock()
+	if handler == nil {
+		return
+	}
+
+	uri, _ := args.GetRequestUri()
+	status, _ := args.GetErrorStatus()
+	var action edge.COREWEBVIEW2_SERVER_CERTIFICATE_ERROR_ACTION = edge.COREWEBVIEW2_SERVER_CERTIFICATE_ERROR_ACTION_CANCEL
+	if handler(uri, int32(status)) {
+		action = edge.COREWEBVIEW2_SERVER_CERTIFICATE_ERROR_ACTION_ALWAYS_ALLOW
+	}
+	if err := args.PutAction(action); err != nil {
+		log.Printf("ServerCertificateErrorDetected: %v", err)
+	}
+}
+
+// OnCertificateError sets a handler that is called on the UI thread when the
+// TLS certificate of the server of url is not valid, for example because it
+// is self-signed or expired. errorStatus tells why, as a
+// COREWEBVIEW2_WEB_ERROR_STATUS value of package edge. Returning true loads
+// the page anyway, and the certificate is then accepted for the host until
+// the browser process exits; false blocks the request. The handler is not
+// called on runtimes too old to report the error, which show their error
+// page. It replaces any previous handler; nil brings back the error page.
+func (w *WebView) OnCertificateError(handler func(url string, errorStatus int32) (proceed bool)) {
+	w.m.Lock()
+	w.onCertificateError = handler
+	w.m.Unlock()
+}
+
+func (w *WebView) contextMenuRequested(sender *edge.ICoreWebView2, args *edge.ICoreWebView2ContextMenuRequestedEventArgs) {
+	w.m.Lock()
+	handler := w.onContextMenuRequested
+	w.m.Unlock()
+	if handler == nil {
+		return
+	}
+
+	location, _ := args.GetLocation()
+	collection, err := args.GetMenuItems()
+	if err != nil {
+		log.Printf("ContextMenuRequested: %v", err)
+		return
+	}
+	defer collection.Release()
+	natives := map[int32]*edge.ICoreWebView2ContextMenuItem{}
+	defer func() {
+		for _, native := range natives {
+			native.Release()
+		}
+	}()
+
+	items := handler(int(location.X), int(location.Y), readContextMenu(collection, natives))
+	if items == nil {
+		args.PutHandled(true)
+		return
+	}
+	actions := map[int32]func(){}
+	w.fillContextMenu(collection, items, natives, actions)
+	w.m.Lock()
+	w.contextMenuActions = actions
+	w.m.Unlock()
+}
+
+// readContextMenu converts the items in collection, keeping a reference to
+// every native item in natives by command id.
+func readContextMenu(collection *edge.ICoreWebView2ContextMenuItemCollection, natives map[int32]*edge.ICoreWebView2ContextMenuItem) []ContextMenuItem {
+	count, _ := collection.GetCount()
+	items := make([]ContextMenuItem, 0, count)
+	for i := uint32(0); i < count; i++ {
+		native, err := collection.GetValueAtIndex(i)
+		if err != nil {
+			continue
+		}
+		commandID, _ := native.GetCommandId()
+		label, _ := native.GetLabel()
+		kind, _ := native.GetKind()
+		natives[commandID] = native
+		item := ContextMenuItem{
+			Label:     label,
+			Kind:      ContextMenuItemKind(kind),
+			builtin:   true,
+			commandID: commandID,
+		}
+		if item.Kind == ContextMenuItemSubmenu {
+			if children, err := native.GetChildren(); err == nil && children != nil {
+				item.Children = readContextMenu(children, natives)
+				children.Release()
+			}
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// fillContextMenu replaces the contents of collection with items, creating
+// native items for the ones made in Go and recording their actions.
+func (w *WebView) fillContextMenu(collection *edge.ICoreWebView2ContextMenuItemCollection, items []ContextMenuItem, natives map[int32]*edge.ICoreWebView2ContextMenuItem, actions map[int32]func()) {
+	count, _ := collection.GetCount()
+	for ; count > 0; count-- {
+		collection.RemoveValueAtIndex(0)
+	}
+
+	var index uint32
+	for _, item := range items {
+		var native *edge.ICoreWebView2ContextMenuItem
+		if item.builtin {
+			native = natives[item.commandID]
+			if native == nil {
+				continue
+			}
+		} else {
+			var err error
+			native, err = w.Browser.CreateContextMenuItem(item.Label, edge.COREWEBVIEW2_CONTEXT_MENU_ITEM_KIND(item.Kind))
+			if err != nil {
+				log.Printf("ContextMenuRequested: %v", err)
+				continue
+			}
+			defer native.Release()
+			if item.Action != nil {
+				commandID, _ := native.GetCommandId()
+				actions[commandID] = item.Action
+			}
+		}
+		if item.Kind == ContextMenuItemSubmenu {
+			if children, err := native.GetChildren(); err == nil && children != nil {
+				w.fillContextMenu(children, item.Children, natives, actions)
+				children.Release()
+			}
+		}
+		collection.InsertValueAtIndex(index, native)
+		index++
+	}
+}
+
+func (w *WebView) customItemSelected(item *edge.ICoreWebView2ContextMenuItem) {
+	commandID, _ := item.GetCommandId()
+	w.m.Lock()
+	action := w.contextMenuActions[commandID]
+	w.m.Unlock()
+	if action != nil {
+		action()
+	}
+}
+
+// OnContextMenuRequested sets a handler that is called on the UI thread when
+// the user opens the context menu at x, y in the webview. It receives the
+// default items and returns the items to show, which may mix default items
+// with new ones; returning nil shows no menu at all. Labels and kinds of
+// default items can not be changed. The handler is not called on runtimes
+// without custom context menu support. It replaces any previous handler.
+func (w *WebView) OnContextMenuRequested(handler func(x, y int, items []ContextMenuItem) []ContextMenuItem) {
+	w.m.Lock()
+	w.onContextMenuRequested = handler
+	w.m.Unlock()
+}
+
+// SuppressDefaultContextMenu turns off the context menu of the webview
+// entirely.
+func (w *WebView) SuppressDefaultContextMenu() {
+	w.onMainThread(func() {
+		settings, err := w.Browser.GetSettings()
+		if err != nil {
+			log.Printf("SuppressDefaultContextMenu: %v", err)
+			return
+		}
+		settings.PutAreDefaultContextMenusEnabled(false)
+	})
+}
+
+func (w *WebView) processFailed(sender *edge.ICoreWebView2, args *edge.ICoreWebView2ProcessFailedEventArgs) {
+	w.m.Lock()
+	handler := w.onProcessFailed
+	w.m.Unlock()
+	if handler == nil {
+		return
+	}
+
+	_kind, _ := args.GetProcessFailedKind()
+	exitCode, _ := args.GetExitCode()
+	var kind ProcessFailedKind
+	switch _kind {
+	case edge.COREWEBVIEW2_PROCESS_FAILED_KIND_BROWSER_PROCESS_EXITED:
+		kind = ProcessFailedBrowserCrash
+	case edge.COREWEBVIEW2_PROCESS_FAILED_KIND_GPU_PROCESS_EXITED:
+		kind = ProcessFailedGPUCrash
+	case edge.COREWEBVIEW2_PROCESS_FAILED_KIND_RENDER_PROCESS_UNRESPONSIVE:
+		kind = ProcessFailedRendererUnresponsive
+	case edge.COREWEBVIEW2_PROCESS_FAILED_KIND_RENDER_PROCESS_EXITED,
+		edge.COREWEBVIEW2_PROCESS_FAILED_KIND_FRAME_RENDER_PROCESS_EXITED:
+		kind = ProcessFailedRenderProcessExited
+		reason, err := args.GetReason()
+		if err == nil && reason != edge.COREWEBVIEW2_PROCESS_FAILED_REASON_TERMINATED {
+			kind = ProcessFailedRendererCrash
+		}
+	default:
+		kind = ProcessFailedOther
+	}
+	handler(kind, exitCode)
+}
+
+// OnProcessFailed sets a handler that is called on the UI thread when one of
+// the WebView2 processes crashed or exited. exitCode is 0 when the runtime
+// does not report it. The handler may call Navigate to reload the page after
+// a renderer failure or Terminate to give up. It replaces any previous
+// handler.
+func (w *WebView) OnProcessFailed(handler func(kind ProcessFailedKind, exitCode int32)) {
+	w.m.Lock()
+	w.onProcessFailed = handler
+	w.m.Unlock()
+}
+
+func (w *WebView) webResourceRequested(request *edge.ICoreWebView2WebResourceRequest, args *edge.ICoreWebView2WebResourceRequestedEventArgs) {
+	defer request.Release()
+	uri, err := request.GetUri()
+	if err != nil {
+		log.Printf("WebResourceRequested: %v", err)
+		return
+	}
+	scheme := uri
+	if i := strings.IndexByte(uri, ':'); i >= 0 {
+		scheme = uri[:i]
+	}
+	w.m.Lock()
+	handler := w.schemeHandlers[strings.ToLower(scheme)]
+	var interceptor func(*ResourceRequest) *ResourceRequest
+	for _, r := range w.resourceInterceptors {
+		if matchFilter(r.filter, uri) {
+			interceptor = r.handler
+			break
+		}
+	}
+	w.m.Unlock()
+	if handler != nil {
+		w.serveScheme(uri, request, args, handler)
+	} else if interceptor != nil {
+		w.interceptRequest(uri, request, args, interceptor)
+	}
+}
+
+func (w *WebView) serveScheme(uri string, request *edge.ICoreWebView2WebResourceRequest, args *edge.ICoreWebView2WebResourceRequestedEventArgs, handler func(*SchemeRequest) *SchemeResponse) {
+	req, err := readResourceRequest(uri, request)
+	if err != nil {
+		log.Printf("WebResourceRequested: %v", err)
+		return
+	}
+	deferral, err := args.GetDeferral()
+	if err != nil {
+		log.Printf("WebResourceRequested: %v", err)
+		return
+	}
+	args.AddRef()
+	go func() {
+		resp := handler(&SchemeRequest{
+			URL:     req.URL,
+			Method:  req.Method,
+			Headers: req.Headers,
+			Body:    bytes.NewReader(req.Body),
+		})
+		if resp == nil {
+			resp = &SchemeResponse{StatusCode: http.StatusNotFound}
+		}
+		var content []byte
+		if resp.Body != nil {
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				log.Printf("WebResourceRequested: %v", err)
+				resp = &SchemeResponse{StatusCode: http.StatusInternalServerError}
+			} else {
+				content = body
+			}
+		}
+		w.Dispatch(func() {
+			defer args.Release()
+			defer deferral.Complete()
+			w.putResponse(args, resp.StatusCode, resp.Headers, content)
+		})
+	}()
+}
+
+func (w *WebView) interceptRequest(uri string, request *edge.ICoreWebView2WebResourceRequest, args *edge.ICoreWebView2WebResourceRequestedEventArgs, interceptor func(*ResourceRequest) *ResourceRequest) {
+	req, err := readResourceRequest(uri, request)
+	if err != nil {
+		log.Printf("WebResourceRequested: %v", err)
+		return
+	}
+	original := *req
+	original.Headers = map[string]string{}
+	for name, value := range req.Headers {
+		original.Headers[name] = value
+	}
+	result := interceptor(req)
+	if result == nil {
+		return
+	}
+	if result.Response != nil {
+		w.putResponse(args, result.Response.StatusCode, result.Response.Headers, result.Response.Body)
+		return
+	}
+	if err := writeResourceRequest(request, &original, result); err != nil {
+		log.Printf("WebResourceRequested: %v", err)
+	}
+}
+
+// putResponse answers the request of args with a response built from status,
+// headers and content. A zero status means 200.
+func (w *WebView) putResponse(args *edge.ICoreWebView2WebResourceRequestedEventArgs, status int, headers map[string]string, content []byte) {
+	if status == 0 {
+		status = http.StatusOK
+	}
+	w.m.Lock()
+	csp := w.csp
+	w.m.Unlock()
+	var lines strings.Builder
+	for name, value := range headers {
+		lines.WriteString(name + ": " + value + "\r\n")
+	}
+	if csp != "" {
+		lines.WriteString("Content-Security-Policy: " + csp + "\r\n")
+	}
+	response, err := w.Browser.Environment().CreateWebResourceResponse(content, status, http.StatusText(status), lines.String())
+	if err != nil {
+		log.Printf("WebResourceRequested: %v", err)
+		return
+	}
+	defer response.Release()
+	if err := args.PutResponse(response); err != nil {
+		log.Printf("WebResourceRequested: %v", err)
+	}
+}
+
+func readResourceRequest(uri string, request *edge.ICoreWebView2WebResourceRequest) (*ResourceRequest, error) {
+	method, err := request.GetMethod()
+	if err != nil {
+		return nil, err
+	}
+	content, err := request.GetContent()
+	if err != nil {
+		return nil, err
+	}
+	headers, err := request.GetHeaders()
+	if err != nil {
+		return nil, err
+	}
+	defer headers.Release()
+	values, err := headers.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	return &ResourceRequest{
+		URL:     uri,
+		Method:  method,
+		Headers: values,
+		Body:    content,
+	}, nil
+}
+
+// writeResourceRequest applies the changes from original to changed to
+// request.
+func writeResourceRequest(request *edge.ICoreWebView2WebResourceRequest, original, changed *ResourceRequest) error {
+	if changed.URL != original.URL {
+		if err := request.PutUri(changed.URL); err != nil {
+			return err
+		}
+	}
+	if changed.Method != original.Method {
+		if err := request.PutMethod(changed.Method); err != nil {
+			return err
+		}
+	}
+	if !bytes.Equal(changed.Body, original.Body) {
+		if err := request.PutContent(changed.Body); err != nil {
+			return err
+		}
+	}
+	headers, err := request.GetHeaders()
+	if err != nil {
+		return err
+	}
+	defer headers.Release()
+	for name := range original.Headers {
+		if _, ok := changed.Headers[name]; !ok {
+			if err := headers.RemoveHeader(name); err != nil {
+				return err
+			}
+		}
+	}
+	for name, value := range changed.Headers {
+		if v, ok := original.Headers[name]; !ok || v != value {
+			if err := headers.SetHeader(name, value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// matchFilter reports whether uri matches filter, in which * stands for any
+// run of characters, as in WebView2 resource filters.
+func matchFilter(filter, uri string) bool {
+	parts := strings.Split(filter, "*")
+	if len(parts) == 1 {
+		return filter == uri
+	}
+	if !strings.HasPrefix(uri, parts[0]) {
+		return false
+	}
+	uri = uri[len(parts[0]):]
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(uri, part)
+		if i < 0 {
+			return false
+		}
+		uri = uri[i+len(part):]
+	}
+	return strings.HasSuffix(uri, last)
+}
+
+// OnWebResourceRequested sets a handler that is called on the UI thread for
+// each request the page makes to a URL matching filter, in which * stands for
+// any run of characters. The handler may return nil to let the request go
+// through unchanged, a changed copy of req to send that instead, or a request
+// with Response set to answer it without going to the network. Filters are
+// tried in the order they were first set; a custom scheme served with
+// SetCustomSchemeHandler takes precedence. It replaces any previous handler
+// for filter, and a nil handler removes it.
+func (w *WebView) OnWebResourceRequested(filter string, handler func(req *ResourceRequest) *ResourceRequest) {
+	w.m.Lock()
+	index := -1
+	for i, r := range w.resourceInterceptors {
+		if r.filter == filter {
+			index = i
+		}
+	}
+	add := handler != nil && index < 0
+	remove := handler == nil && index >= 0
+	switch {
+	case add:
+		w.resourceInterceptors = append(w.resourceInterceptors, resourceInterceptor{filter, handler})
+	case remove:
+		w.resourceInterceptors = append(w.resourceInterceptors[:index], w.resourceInterceptors[index+1:]...)
+	case handler != nil:
+		w.resourceInterceptors[index].handler = handler
+	}
+	w.m.Unlock()
+	if !add && !remove {
+		return
+	}
+	w.onMainThread(func() {
+		if add {
+			w.Browser.AddWebResourceRequestedFilter(filter, edge.COREWEBVIEW2_WEB_RESOURCE_CONTEXT_ALL)
+		} else if err := w.Browser.RemoveWebResourceRequestedFilter(filter, edge.COREWEBVIEW2_WEB_RESOURCE_CONTEXT_ALL); err != nil {
+			log.Printf("OnWebResourceRequested: %v", err)
+		}
+	})
+}
+
+func (w *WebView) webResourceResponseReceived(sender *edge.ICoreWebView2, args *edge.ICoreWebView2WebResourceResponseReceivedEventArgs) {
+	w.m.Lock()
+	handler := w.onResponseReceived
+	w.m.Unlock()
+	if handler == nil {
+		return
+	}
+
+	request, err := args.GetRequest()
+	if err != nil {
+		log.Printf("WebResourceResponseReceived: %v", err)
+		return
+	}
+	defer request.Release()
+	url, _ := request.GetUri()
+	response, err := args.GetResponse()
+	if err != nil {
+		log.Printf("WebResourceResponseReceived: %v", err)
+		return
+	}
+	defer response.Release()
+	statusCode, _ := response.GetStatusCode()
+	headers := map[string]string{}
+	if h, err := response.GetHeaders(); err == nil {
+		if values, err := h.ReadAll(); err == nil {
+			headers = values
+		}
+		h.Release()
+	}
+	w.Dispatch(func() {
+		handler(url, int(statusCode), headers)
+	})
+}
+
+// OnWebResourceResponseReceived sets a handler that is called with the URL,
+// HTTP status and headers of each response the webview receives. The handler
+// runs from the dispatch queue, after the response has been passed on to the
+// page. It replaces any previous handler.
+func (w *WebView) OnWebResourceResponseReceived(handler func(url string, statusCode int, headers map[string]string)) {
+	w.m.Lock()
+	w.onResponseReceived = handler
+	w.m.Unlock()
+}
+
+// SetCustomSchemeHandler serves requests for URIs of scheme, such as "app",
+// with handler. The handler runs on its own goroutine; a nil response
+// answers 404. The scheme must have been registered when the webview was
+// created, with WithCustomScheme or through the EnvironmentOptions of a
+// SharedEnvironment. A nil handler stops serving the scheme.
+func (w *WebView) SetCustomSchemeHandler(scheme string, handler func(req *SchemeRequest) *SchemeResponse) {
+	scheme = strings.ToLower(scheme)
+	w.m.Lock()
+	_, registered := w.schemeHandlers[scheme]
+	if handler == nil {
+		delete(w.schemeHandlers, scheme)
+	} else {
+		w.schemeHandlers[scheme] = handler
+	}
+	w.m.Unlock()
+	if registered || handler == nil {
+		return
+	}
+	w.onMainThread(func() {
+		w.Browser.AddWebResourceRequestedFilter(scheme+":*", edge.COREWEBVIEW2_WEB_RESOURCE_CONTEXT_ALL)
+	})
+}
+
+// SetVirtualHostMapping serves the files in folderPath under
+// https://hostName/, so a local app can be loaded from a URL such as
+// https://app.local/index.html. accessKind controls which other pages may
+// load those files.
+func (w *WebView) SetVirtualHostMapping(hostName, folderPath string, accessKind AccessKind) error {
+	var err error
+	w.dispatchSync(func() {
+		err = w.Browser.SetVirtualHostNameToFolderMapping(hostName, folderPath, edge.COREWEBVIEW2_HOST_RESOURCE_ACCESS_KIND(accessKind))
+	})
+	return err
+}
+
+// ClearVirtualHostMapping removes the mapping of hostName set with
+// SetVirtualHostMapping.
+func (w *WebView) ClearVirtualHostMapping(hostName string) error {
+	var err error
+	w.dispatchSync(func() {
+		err = w.Browser.ClearVirtualHostNameToFolderMapping(hostName)
+	})
+	return err
+}
+
+// CookieManager returns the cookie manager of the webview's profile, or nil
+// if the installed runtime has none. Its methods must be called on the UI
+// thread, for example from Dispatch.
+func (w *WebView) CookieManager() *edge.CookieManager {
+	var manager *edge.CookieManager
+	w.dispatchSync(func() {
+		var err error
+		if manager, err = w.Browser.CookieManager(); err != nil {
+			log.Printf("CookieManager: %v", err)
+		}
+	})
+	return manager
+}
+
+// GetCookies returns the cookies the webview would send to url, or all cookies
+// of its profile when url is empty.
+func (w *WebView) GetCookies(url string) ([]Cookie, error) {
+	var cookies []edge.Cookie
+	var err error
+	w.dispatchSync(func() {
+		var manager *edge.CookieManager
+		if manager, err = w.Browser.CookieManager(); err == nil {
+			cookies, err = manager.GetCookies(url)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]Cookie, len(cookies))
+	for i, c := range cookies {
+		result[i] = Cookie{
+			Name:     c.Name,
+			Value:    c.Value,
+			Domain:   c.Domain,
+			Path:     c.Path,
+			Secure:   c.IsSecure,
+			HTTPOnly: c.IsHttpOnly,
+			SameSite: SameSite(c.SameSite),
+			Expires:  c.Expires,
+		}
+	}
+	return result, nil
+}
+
+// SetCookie adds c to the webview's profile, or replaces the cookie with the
+// same name, domain and path.
+func (w *WebView) SetCookie(c Cookie) error {
+	var err error
+	w.dispatchSync(func() {
+		var manager *edge.CookieManager
+		if manager, err = w.Browser.CookieManager(); err == nil {
+			err = manager.AddCookie(edge.Cookie{
+				Name:       c.Name,
+				Value:      c.Value,
+				Domain:     c.Domain,
+				Path:       c.Path,
+				IsSecure:   c.Secure,
+				IsHttpOnly: c.HTTPOnly,
+				SameSite:   edge.COREWEBVIEW2_COOKIE_SAME_SITE_KIND(c.SameSite),
+				Expires:    c.Expires,
+			})
+		}
+	})
+	return err
+}
+
+// DeleteCookie deletes the cookies named name that the webview would send to
+// url.
+func (w *WebView) DeleteCookie(url, name string) error {
+	var err error
+	w.dispatchSync(func() {
+		var manager *edge.CookieManager
+		if manager, err = w.Browser.CookieManager(); err != nil {
+			return
+		}
+		var cookies []edge.Cookie
+		if cookies, err = manager.GetCookies(url); err != nil {
+			return
+		}
+		for _, c := range cookies {
+			if c.Name != name {
+				continue
+			}
+			if err = manager.DeleteCookie(c); err != nil {
+				return
+			}
+		}
+	})
+	return err
+}
+
+// browsingDataKinds maps each DataKind to the WebView2 data kinds it clears.
+var browsingDataKinds = map[DataKind]edge.COREWEBVIEW2_BROWSING_DATA_KINDS{
+	DataKindCookies:       edge.COREWEBVIEW2_BROWSING_DATA_KINDS_COOKIES,
+	DataKindFileCache:     edge.COREWEBVIEW2_BROWSING_DATA_KINDS_DISK_CACHE | edge.COREWEBVIEW2_BROWSING_DATA_KINDS_CACHE_STORAGE,
+	DataKindLocalStorage:  edge.COREWEBVIEW2_BROWSING_DATA_KINDS_LOCAL_STORAGE,
+	DataKindAllDOMStorage: edge.COREWEBVIEW2_BROWSING_DATA_KINDS_ALL_DOM_STORAGE,
+	DataKindIndexedDB:     edge.COREWEBVIEW2_BROWSING_DATA_KINDS_INDEXED_DB,
+	DataKindFormData:      edge.COREWEBVIEW2_BROWSING_DATA_KINDS_GENERAL_AUTOFILL,
+	DataKindPasswords:     edge.COREWEBVIEW2_BROWSING_DATA_KINDS_PASSWORD_AUTOSAVE,
+}
+
+// ClearBrowserData deletes the given kinds of browsing data of the webview's
+// profile and blocks until it is done. It gives up after 30 seconds.
+func (w *WebView) ClearBrowserData(kinds DataKind) error {
+	ctx, cancel := context.WithTimeout(context.Background(), asyncTimeout)
+	defer cancel()
+	return w.ClearBrowserDataContext(ctx, kinds)
+}
+
+// ClearBrowserDataContext is like ClearBrowserData but waits until ctx is
+// done instead of using the default timeout.
+func (w *WebView) ClearBrowserDataContext(ctx context.Context, kinds DataKind) error {
+	var dataKinds edge.COREWEBVIEW2_BROWSING_DATA_KINDS
+	if kinds == DataKindAll {
+		dataKinds = edge.COREWEBVIEW2_BROWSING_DATA_KINDS_ALL_PROFILE
+	} else {
+		for kind, k := range browsingDataKinds {
+			if kinds&kind != 0 {
+				dataKinds |= k
+			}
+		}
+	}
+	if dataKinds == 0 {
+		return nil
+	}
+
+	var clearErr error
+	err := w.await(ctx, func(done func()) {
+		err := w.Browser.ClearBrowsingData(dataKinds, func(err error) {
+			clearErr = err
+			done()
+		})
+		if err != nil {
+			clearErr = err
+			done()
+		}
+	})
+	if err != nil {
+		return err
+	}
+	return clearErr
+}
+
+// ClearCache empties the HTTP cache of the webview's profile through the
+// DevTools protocol, leaving cookies and other browsing data alone.
+func (w *WebView) ClearCache() error {
+	_, err := w.ExecuteCDPCommand("Network.clearBrowserCache", nil)
+	return err
+}
+
+// ClearServiceWorkerRegistrations unregisters all service workers of the
+// webview's profile through the DevTools protocol, so that pages are loaded
+// from the network again instead of from their workers.
+func (w *WebView) ClearServiceWorkerRegistrations() error {
+	// Enabling the domain reports the existing registrations as events,
+	// which arrive on the UI thread before the reply to the enable command.
+	var m sync.Mutex
+	scopes := map[string]struct{}{}
+	var remove func()
+	var err error
+	w.dispatchSync(func() {
+		remove, err = w.Browser.AddDevToolsProtocolEventReceived("ServiceWorker.workerRegistrationUpdated", func(params string) {
+			var event struct {
+				Registrations []struct {
+					ScopeURL  string
+					IsDeleted bool
+				}
+			}
+			if json.Unmarshal([]byte(params), &event) != nil {
+				return
+			}
+			m.Lock()
+			defer m.Unlock()
+			for _, r := range event.Registrations {
+				if r.IsDeleted {
+					delete(scopes, r.ScopeURL)
+				} else {
+					scopes[r.ScopeURL] = struct{}{}
+				}
+			}
+		})
+	})
+	if err != nil {
+		return err
+	}
+	defer w.onMainThread(remove)
+
+	if _, err := w.ExecuteCDPCommand("ServiceWorker.enable", nil); err != nil {
+		return err
+	}
+	defer w.ExecuteCDPCommand("ServiceWorker.disable", nil)
+
+	m.Lock()
+	urls := make([]string, 0, len(scopes))
+	for scope := range scopes {
+		urls = append(urls, scope)
+	}
+	m.Unlock()
+	for _, scope := range urls {
+		params, _ := json.Marshal(map[string]string{"scopeURL": scope})
+		if _, err := w.ExecuteCDPCommand("ServiceWorker.unregister", params); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetUserAgent sets the User-Agent header the webview sends, from the next
+// request on. An empty ua restores the default one.
+func (w *WebView) SetUserAgent(ua string) {
+	w.onMainThread(func() {
+		if w.defaultUserAgent == "" {
+			defaultUserAgent, err := w.Browser.GetUserAgent()
+			if err != nil {
+				log.Printf("SetUserAgent: %v", err)
+				return
+			}
+			w.defaultUserAgent = defaultUserAgent
+		}
+		if ua == "" {
+			ua = w.defaultUserAgent
+		}
+		if err := w.Browser.PutUserAgent(ua); err != nil {
+			log.Printf("SetUserAgent: %v", err)
+		}
+	})
+}
+
+// GetUserAgent returns the User-Agent header the webview sends.
+func (w *WebView) GetUserAgent() string {
+	var ua string
+	w.dispatchSync(func() {
+		ua, _ = w.Browser.GetUserAgent()
+	})
+	return ua
+}
+
+// OpenDevTools opens the DevTools window of the webview, or focuses it if it
+// is already open. It only works when the webview was created with
+// WithDebug.
+func (w *WebView) OpenDevTools() {
+	w.onMainThread(func() {
+		if !w.Browser.Debug {
+			log.Printf("OpenDevTools: DevTools are disabled, see WithDebug")
+			return
+		}
+		if w.devToolsHook == 0 {
+			w.hookDevTools()
+		}
+		if len(w.devToolsWindows) == 0 {
+			w.devToolsOpening++
+		}
+		if err := w.Browser.OpenDevToolsWindow(); err != nil {
+			log.Printf("OpenDevTools: %v", err)
+		}
+	})
+}
+
+// IsDevToolsOpen reports whether a DevTools window opened with OpenDevTools
+// is open. DevTools opened by the user are only seen once OpenDevTools has
+// been called.
+func (w *WebView) IsDevToolsOpen() bool {
+	var open bool
+	w.dispatchSync(func() {
+		open = len(w.devToolsWindows) > 0
+	})
+	return open
+}
+
+// hookDevTools watches the top-level windows of the browser process, as the
+// DevTools window belongs to it rather than to the webview.
+func (w *WebView) hookDevTools() {
+	processID, err := w.Browser.GetBrowserProcessID()
+	if err != nil {
+		log.Printf("OpenDevTools: %v", err)
+		return
+	}
+	hook, _, _ := w32.User32SetWinEventHook.Call(
+		w32.EventObjectDestroy,
+		w32.EventObjectShow,
+		0,
+		devToolsEventProc,
+		uintptr(processID),
+		0,
+		w32.WinEventOutOfContext,
+	)
+	if hook == 0 {
+		return
+	}
+	devToolsHooksSync.Lock()
+	devToolsHooks[hook] = w
+	devToolsHooksSync.Unlock()
+	w.devToolsHook = hook
+}
+
+func (w *WebView) unhookDevTools() {
+	if w.devToolsHook == 0 {
+		return
+	}
+	w32.User32UnhookWinEvent.Call(w.devToolsHook)
+	devToolsHooksSync.Lock()
+	delete(devToolsHooks, w.devToolsHook)
+	devToolsHooksSync.Unlock()
+	w.devToolsHook = 0
+}
+
+// devToolsEvent is called on the UI thread when a window of the browser
+// process is shown or destroyed. A top-level window shown after OpenDevTools,
+// or titled like one, is taken to be the DevTools window.
+func devToolsEvent(hook, event, hwnd, idObject, idChild, thread, eventTime uintptr) uintptr {
+	if int32(idObject) != w32.ObjIDWindow || idChild != 0 {
+		return 0
+	}
+	devToolsHooksSync.Lock()
+	w := devToolsHooks[hook]
+	devToolsHooksSync.Unlock()
+	if w == nil {
+		return 0
+	}
+
+	switch event {
+	case w32.EventObjectShow:
+		if _, ok := w.devToolsWindows[hwnd]; ok {
+			return 0
+		}
+		if root, _, _ := w32.User32GetAncestor.Call(hwnd, w32.GARoot); root != hwnd {
+			return 0
+		}
+		if w.devToolsOpening > 0 || strings.HasPrefix(windowText(hwnd), "DevTools") {
+			if w.devToolsOpening > 0 {
+				w.devToolsOpening--
+			}
+			w.devToolsWindows[hwnd] = struct{}{}
+		}
+	case w32.EventObjectDestroy:
+		delete(w.devToolsWindows, hwnd)
+	}
+	return 0
+}
+
+func windowText(hwnd uintptr) string {
+	buf := make([]uint16, 256)
+	n, _, _ := w32.User32GetWindowTextW.Call(hwnd, uintptr(unsafe.Pointer(&buf[0])), uintptr(len(buf)))
+	return windows.UTF16ToString(buf[:n])
+}
+
+// CaptureScreenshot returns a PNG image of the visible part of the page. It
+// returns ErrWebViewNotReady until the first navigation has completed and
+// gives up after 30 seconds.
+func (w *WebView) CaptureScreenshot() ([]byte, error) {
+	w.m.Lock()
+	navigated := w.navigated
+	w.m.Unlock()
+	if !navigated {
+		return nil, ErrWebViewNotReady
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), asyncTimeout)
+	defer cancel()
+	var image []byte
+	var captureErr error
+	err := w.await(ctx, func(done func()) {
+		err := w.Browser.CapturePreview(edge.COREWEBVIEW2_CAPTURE_PREVIEW_IMAGE_FORMAT_PNG, func(data []byte, err error) {
+			image, captureErr = data, err
+			done()
+		})
+		if err != nil {
+			captureErr = err
+			done()
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return image, captureErr
+}
+
+// printSettings converts opts for the PrintToPdf calls. A nil opts gives nil
+// settings, which WebView2 takes as its defaults.
+func (w *WebView) printSettings(opts *PDFPrintSettings) (*edge.ICoreWebView2PrintSettings, error) {
+	if opts == nil {
+		return nil, nil
+	}
+	settings, err := w.Browser.CreatePrintSettings()
+	if err != nil {
+		return nil, err
+	}
+	puts := []func() error{
+		func() error {
+			return settings.PutOrientation(edge.COREWEBVIEW2_PRINT_ORIENTATION(opts.Orientation))
+		},
+		func() error { return settings.PutMarginTop(opts.MarginTop) },
+		func() error { return settings.PutMarginBottom(opts.MarginBottom) },
+		func() error { return settings.PutMarginLeft(opts.MarginLeft) },
+		func() error { return settings.PutMarginRight(opts.MarginRight) },
+		func() error { return settings.PutShouldPrintBackgrounds(opts.PrintBackgrounds) },
+		func() error { return settings.PutShouldPrintHeaderAndFooter(opts.HeaderFooter) },
+	}
+	if opts.PageWidth > 0 {
+		puts = append(puts, func() error { return settings.PutPageWidth(opts.PageWidth) })
+	}
+	if opts.PageHeight > 0 {
+		puts = append(puts, func() error { return settings.PutPageHeight(opts.PageHeight) })
+	}
+	for _, put := range puts {
+		if err := put(); err != nil {
+			settings.Release()
+			return nil, err
+		}
+	}
+	return settings, nil
+}
+
+// PrintToPDF saves the page as a PDF file at path and blocks until it is
+// written. A nil opts uses the defaults of WebView2: Letter paper in portrait
+// with 0.4 inch margins. It gives up after 30 seconds.
+func (w *WebView) PrintToPDF(path string, opts *PDFPrintSettings) error {
+	ctx, cancel := context.WithTimeout(context.Background(), asyncTimeout)
+	defer cancel()
+	var printErr error
+	err := w.await(ctx, func(done func()) {
+		settings, err := w.printSettings(opts)
+		if err != nil {
+			printErr = err
+			done()
+			return
+		}
+		if settings != nil {
+			defer settings.Release()
+		}
+		err = w.Browser.PrintToPdf(path, settings, func(err error) {
+			printErr = err
+			done()
+		})
+		if err != nil {
+			printErr = err
+			done()
+		}
+	})
+	if err != nil {
+		return err
+	}
+	return printErr
+}
+
+// PrintToPDFBytes is like PrintToPDF with the default settings, but returns
+// the PDF instead of writing it to a file.
+func (w *WebView) PrintToPDFBytes() ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), asyncTimeout)
+	defer cancel()
+	var pdf []byte
+	var printErr error
+	err := w.await(ctx, func(done func()) {
+		err := w.Browser.PrintToPdfStream(nil, func(data []byte, err error) {
+			pdf, printErr = data, err
+			done()
+		})
+		if err != nil {
+			printErr = err
+			done()
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pdf, printErr
+}
+
+// Print prints the page without showing a dialog, configured by opts, which
+// may be nil to print once to the default printer. It returns once printing
+// has started; the OnPrintCompleted handler is told how it went.
+func (w *WebView) Print(opts *PrintSettings) error {
+	var err error
+	w.dispatchSync(func() {
+		var settings *edge.ICoreWebView2PrintSettings
+		if opts != nil {
+			if settings, err = w.Browser.CreatePrintSettings(); err != nil {
+				return
+			}
+			defer settings.Release()
+			if err = applyPrintSettings(settings, opts); err != nil {
+				return
+			}
+		}
+		err = w.Browser.Print(settings, func(status edge.COREWEBVIEW2_PRINT_STATUS, err error) {
+			if err != nil {
+				log.Printf("Print: %v", err)
+			}
+			w.m.Lock()
+			handler := w.onPrintCompleted
+			w.m.Unlock()
+			if handler != nil {
+				handler(err == nil && status == edge.COREWEBVIEW2_PRINT_STATUS_SUCCEEDED)
+			}
+		})
+	})
+	return err
+}
+
+func applyPrintSettings(settings *edge.ICoreWebView2PrintSettings, opts *PrintSettings) error {
+	settings2 := settings.GetICoreWebView2PrintSettings2()
+	if settings2 == nil {
+		return edge.ErrNotSupported
+	}
+	defer settings2.Release()
+	if opts.PrinterName != "" {
+		if err := settings2.PutPrinterName(opts.PrinterName); err != nil {
+			return err
+		}
+	}
+	if opts.Copies > 0 {
+		if err := settings2.PutCopies(int32(opts.Copies)); err != nil {
+			return err
+		}
+	}
+	if err := settings2.PutDuplex(edge.COREWEBVIEW2_PRINT_DUPLEX(opts.Duplex)); err != nil {
+		return err
+	}
+	if err := settings2.PutColorMode(edge.COREWEBVIEW2_PRINT_COLOR_MODE(opts.ColorMode)); err != nil {
+		return err
+	}
+	if opts.Collation {
+		if err := settings2.PutCollation(edge.COREWEBVIEW2_PRINT_COLLATION_COLLATED); err != nil {
+			return err
+		}
+	}
+	if opts.StartPage > 0 {
+		pages := strconv.Itoa(opts.StartPage) + "-"
+		if opts.EndPage > 0 {
+			pages += strconv.Itoa(opts.EndPage)
+		}
+		if err := settings2.PutPageRanges(pages); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnPrintCompleted sets a handler that is called on the UI thread when a
+// print job started with Print has been sent to the printer, or has failed.
+// It replaces any previous handler.
+func (w *WebView) OnPrintCompleted(handler func(success bool)) {
+	w.m.Lock()
+	w.onPrintCompleted = handler
+	w.m.Unlock()
+}
+
+// SetDefaultDownloadFolder makes downloads go to the folder at path, which
+// must exist and be writable, instead of the Downloads folder of the user.
+func (w *WebView) SetDefaultDownloadFolder(path string) error {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+	if info, err := os.Stat(path); err != nil {
+		return err
+	} else if !info.IsDir() {
+		return fmt.Errorf("%s is not a folder", path)
+	}
+	f, err := os.CreateTemp(path, "webview2-")
+	if err != nil {
+		return fmt.Errorf("%s is not writable: %w", path, err)
+	}
+	f.Close()
+	os.Remove(f.Name())
+
+	w.dispatchSync(func() {
+		err = w.Browser.PutDefaultDownloadFolderPath(path)
+	})
+	return err
+}
+
+// GetDefaultDownloadFolder returns the folder downloads are saved to.
+func (w *WebView) GetDefaultDownloadFolder() string {
+	var path string
+	w.dispatchSync(func() {
+		path, _ = w.Browser.GetDefaultDownloadFolderPath()
+	})
+	return path
+}
+
+// SetPreferredColorScheme makes pages see scheme in the prefers-color-scheme
+// media query, regardless of the system setting, for example to follow a dark
+// mode toggle of the app. It applies to all webviews of the profile and has
+// no effect on runtimes without profile support.
+func (w *WebView) SetPreferredColorScheme(scheme ColorScheme) {
+	w.onMainThread(func() {
+		if err := w.Browser.PutPreferredColorScheme(edge.COREWEBVIEW2_PREFERRED_COLOR_SCHEME(scheme)); err != nil {
+			log.Printf("SetPreferredColorScheme: %v", err)
+		}
+	})
+}
+
+// DownloadOperation is a download started by the webview. It is passed to the
+// handler set with OnDownloadStarted.
+type DownloadOperation struct {
+	// URI is the address the file is downloaded from.
+	URI string
+	// MIMEType is the MIME type reported by the server.
+	MIMEType string
+	// SuggestedFilename is the name the file would be saved under.
+	SuggestedFilename string
+	// TotalBytes is the size of the file, or -1 if the server did not report
+	// it.
+	TotalBytes int64
+
+	w           *WebView
+	op          *edge.ICoreWebView2DownloadOperation
+	resultPath  string
+	paused      bool
+	onCompleted func(succeeded bool, resultPath string)
+}
+
+// do runs f with the download on the UI thread, unless the download has
+// already finished.
+func (d *DownloadOperation) do(name string, f func(op *edge.ICoreWebView2DownloadOperation) error) {
+	d.w.onMainThread(func() {
+		d.w.m.Lock()
+		op := d.op
+		d.w.m.Unlock()
+		if op == nil {
+			return
+		}
+		if err := f(op); err != nil {
+			log.Printf("%s: %v", name, err)
+		}
+	})
+}
+
+// Cancel stops the download and removes its partial file.
+func (d *DownloadOperation) Cancel() {
+	d.do("Cancel", func(op *edge.ICoreWebView2DownloadOperation) error {
+		return op.Cancel()
+	})
+}
+
+// Pause suspends the download until Resume is called.
+func (d *DownloadOperation) Pause() {
+	d.do("Pause", func(op *edge.ICoreWebView2DownloadOperation) error {
+		d.w.m.Lock()
+		d.paused = true
+		d.w.m.Unlock()
+		return op.Pause()
+	})
+}
+
+// Resume continues a download suspended with Pause.
+func (d *DownloadOperation) Resume() {
+	d.do("Resume", func(op *edge.ICoreWebView2DownloadOperation) error {
+		d.w.m.Lock()
+		d.paused = false
+		d.w.m.Unlock()
+		return op.Resume()
+	})
+}
+
+// SetResultFilePath saves the download to path without showing the save
+// dialog. It only has an effect when called from the OnDownloadStarted
+// handler.
+func (d *DownloadOperation) SetResultFilePath(path string) {
+	d.w.m.Lock()
+	d.resultPath = path
+	d.w.m.Unlock()
+}
+
+// OnCompleted sets a handler that is called on the UI thread when the
+// download has finished, with the path of the saved file. succeeded is false
+// if the download was cancelled or failed. It replaces any previous handler.
+func (d *DownloadOperation) OnCompleted(handler func(succeeded bool, resultPath string)) {
+	d.w.m.Lock()
+	d.onCompleted = handler
+	d.w.m.Unlock()
+}
+
+func (w *WebView) downloadStarting(sender *edge.ICoreWebView2, args *edge.ICoreWebView2DownloadStartingEventArgs) {
+	w.m.Lock()
+	handler := w.onDownloadStarted
+	w.m.Unlock()
+	if handler == nil {
+		return
+	}
+
+	op, err := args.GetDownloadOperation()
+	if err != nil {
+		log.Printf("DownloadStarting: %v", err)
+		return
+	}
+	d := &DownloadOperation{w: w, op: op}
+	d.URI, _ = op.GetUri()
+	d.MIMEType, _ = op.GetMimeType()
+	d.TotalBytes, _ = op.GetTotalBytesToReceive()
+	if path, err := args.GetResultFilePath(); err == nil && path != "" {
+		d.SuggestedFilename = filepath.Base(path)
+	}
+	w.m.Lock()
+	w.downloads[op] = d
+	w.m.Unlock()
+
+	handler(d)
+
+	w.m.Lock()
+	path := d.resultPath
+	w.m.Unlock()
+	if path == "" {
+		return
+	}
+	if err := args.PutResultFilePath(path); err != nil {
+		log.Printf("SetResultFilePath: %v", err)
+		return
+	}
+	if err := args.PutHandled(true); err != nil {
+		log.Printf("SetResultFilePath: %v", err)
+	}
+}
+
+func (w *WebView) downloadStateChanged(op *edge.ICoreWebView2DownloadOperation) {
+	state, err := op.GetState()
+	if err != nil {
+		log.Printf("DownloadStateChanged: %v", err)
+		return
+	}
+
+	w.m.Lock()
+	d := w.downloads[op]
+	if d == nil || state == edge.COREWEBVIEW2_DOWNLOAD_STATE_IN_PROGRESS ||
+		(state == edge.COREWEBVIEW2_DOWNLOAD_STATE_INTERRUPTED && d.paused) {
+		w.m.Unlock()
+		return
+	}
+	delete(w.downloads, op)
+	d.op = nil
+	handler := d.onCompleted
+	w.m.Unlock()
+
+	path, _ := op.GetResultFilePath()
+	op.Release()
+	if handler != nil {
+		handler(state == edge.COREWEBVIEW2_DOWNLOAD_STATE_COMPLETED, path)
+	}
+}
+
+// OnDownloadStarted sets a handler that is called on the UI thread when the
+// webview starts a download. The handler can cancel or redirect the download,
+// and observe it through the DownloadOperation. It replaces any previous
+// handler.
+func (w *WebView) OnDownloadStarted(handler func(d *DownloadOperation)) {
+	w.m.Lock()
+	w.onDownloadStarted = handler
+	w.m.Unlock()
+}
+
+// enableRuntime turns on the Runtime domain of the DevTools Protocol, which
+// reports console messages and exceptions. It must be called on the UI
+// thread.
+func (w *WebView) enableRuntime() {
+	if w.runtimeEnabled {
+		return
+	}
+	w.runtimeEnabled = true
+	err := w.Browser.CallDevToolsProtocolMethod("Runtime.enable", "{}", func(_ string, err error) {
+		if err != nil {
+			log.Printf("Runtime.enable: %v", err)
+		}
+	})
+	if err != nil {
+		log.Printf("Runtime.enable: %v", err)
+	}
+}
+
+// consoleLevels maps the type of a Runtime.consoleAPICalled event to a
+// ConsoleLevel.
+var consoleLevels = map[string]ConsoleLevel{
+	"log":     ConsoleLevelLog,
+	"info":    ConsoleLevelInfo,
+	"warning": ConsoleLevelWarning,
+	"error":   ConsoleLevelError,
+	"assert":  ConsoleLevelError,
+	"debug":   ConsoleLevelDebug,
+	"trace":   ConsoleLevelDebug,
+}
+
+// cdpRemoteObject is a JavaScript value as the DevTools Protocol reports it.
+type cdpRemoteObject struct {
+	Type        string          `json:"type"`
+	Value       json.RawMessage `json:"value"`
+	Description string          `json:"description"`
+}
+
+// String formats the value the way the console prints it.
+func (o cdpRemoteObject) String() string {
+	var s string
+	switch {
+	case json.Unmarshal(o.Value, &s) == nil:
+		return s
+	case len(o.Value) > 0:
+		return string(o.Value)
+	case o.Description != "":
+		return o.Description
+	}
+	return o.Type
+}
+
+// cdpStackTrace is the call stack of a DevTools Protocol event.
+type cdpStackTrace struct {
+	CallFrames []struct {
+		URL        string `json:"url"`
+		LineNumber int    `json:"lineNumber"`
+	} `json:"callFrames"`
+}
+
+func (w *WebView) consoleAPICalled(params string) {
+	w.m.Lock()
+	handler := w.onConsoleMessage
+	w.m.Unlock()
+	if handler == nil {
+		return
+	}
+
+	var event struct {
+		Type       string            `json:"type"`
+		Args       []cdpRemoteObject `json:"args"`
+		StackTrace cdpStackTrace     `json:"stackTrace"`
+	}
+	if err := json.Unmarshal([]byte(params), &event); err != nil {
+		log.Printf("Runtime.consoleAPICalled: %v", err)
+		return
+	}
+	args := make([]string, len(event.Args))
+	for i, arg := range event.Args {
+		args[i] = arg.String()
+	}
+	level, ok := consoleLevels[event.Type]
+	if !ok {
+		level = ConsoleLevelLog
+	}
+	var source string
+	var line int
+	if frames := event.StackTrace.CallFrames; len(frames) > 0 {
+		// The DevTools Protocol counts lines from 0.
+		source, line = frames[0].URL, frames[0].LineNumber+1
+	}
+	message := strings.Join(args, " ")
+	w.Dispatch(func() {
+		handler(level, message, source, line)
+	})
+}
+
+// OnConsoleMessage sets a handler that is called with every message the page
+// writes to the console, along with the script URL and line it came from.
+// The handler runs from the dispatch queue. It replaces any previous handler.
+func (w *WebView) OnConsoleMessage(handler func(level ConsoleLevel, message, source string, line int)) {
+	w.m.Lock()
+	w.onConsoleMessage = handler
+	w.m.Unlock()
+	w.onMainThread(func() {
+		if w.consoleSubscribed {
+			return
+		}
+		_, err := w.Browser.AddDevToolsProtocolEventReceived("Runtime.consoleAPICalled", w.consoleAPICalled)
+		if err != nil {
+			log.Printf("OnConsoleMessage: %v", err)
+			return
+		}
+		w.consoleSubscribed = true
+		w.enableRuntime()
+	})
+}
+
+func (w *WebView) exceptionThrown(params json.RawMessage) {
+	var event struct {
+		ExceptionDetails struct {
+			Text         string          `json:"text"`
+			LineNumber   int             `json:"lineNumber"`
+			ColumnNumber int             `json:"columnNumber"`
+			URL          string          `json:"url"`
+			Exception    cdpRemoteObject `json:"exception"`
+		} `json:"exceptionDetails"`
+	}
+	if err := json.Unmarshal(params, &event); err != nil {
+		log.Printf("Runtime.exceptionThrown: %v", err)
+		return
+	}
+	details := event.ExceptionDetails
+	// The description of an Error starts with its message, followed by the
+	// stack.
+	reason := details.Exception.String()
+	if i := strings.IndexByte(reason, '\n'); i >= 0 {
+		reason = reason[:i]
+	}
+
+	w.m.Lock()
+	onScriptError, onPromiseRejection := w.onScriptError, w.onPromiseRejection
+	w.m.Unlock()
+	if strings.HasPrefix(details.Text, "Uncaught (in promise)") {
+		if onPromiseRejection != nil {
+			onPromiseRejection(reason)
+		}
+		return
+	}
+	if onScriptError != nil {
+		message := details.Text
+		if details.Exception.Type != "" {
+			message += " " + reason
+		}
+		// The DevTools Protocol counts lines and columns from 0.
+		onScriptError(details.URL, details.LineNumber+1, details.ColumnNumber+1, message)
+	}
+}
+
+// subscribeExceptions starts listening for uncaught exceptions of the page.
+func (w *WebView) subscribeExceptions() {
+	w.m.Lock()
+	subscribed := w.exceptionsSubscribed
+	w.exceptionsSubscribed = true
+	w.m.Unlock()
+	if subscribed {
+		return
+	}
+	if _, err := w.SubscribeCDPEvent("Runtime.exceptionThrown", w.exceptionThrown); err != nil {
+		log.Printf("Runtime.exceptionThrown: %v", err)
+		w.m.Lock()
+		w.exceptionsSubscribed = false
+		w.m.Unlock()
+		return
+	}
+	w.onMainThread(w.enableRuntime)
+}
+
+// OnScriptError sets a handler that is called from the dispatch queue when a
+// script of the page throws an exception that is not caught, with the URL,
+// line and column of the script and the message of the exception. It
+// replaces any previous handler.
+func (w *WebView) OnScriptError(handler func(url string, line, column int, errorMessage string)) {
+	w.m.Lock()
+	w.onScriptError = handler
+	w.m.Unlock()
+	w.subscribeExceptions()
+}
+
+// OnUnhandledPromiseRejection sets a handler that is called from the dispatch
+// queue when a promise of the page is rejected and nothing handles it, with
+// the rejection reason. It replaces any previous handler.
+func (w *WebView) OnUnhandledPromiseRejection(handler func(reason string)) {
+	w.m.Lock()
+	w.onPromiseRejection = handler
+	w.m.Unlock()
+	w.subscribeExceptions()
+}
+
+// SetBackgroundColor sets the color the webview shows where the page does not
+// paint, and before the first page has loaded, in place of white. WebView2
+// only supports fully opaque or fully transparent colors, so a must be 0 or
+// 255. Use WithBackgroundColor to avoid a white flash when the window opens.
+func (w *WebView) SetBackgroundColor(r, g, b, a uint8) {
+	w.onMainThread(func() {
+		err := w.Browser.PutDefaultBackgroundColor(edge.COREWEBVIEW2_COLOR{R: r, G: g, B: b, A: a})
+		if err != nil {
+			log.Printf("SetBackgroundColor: %v", err)
+		}
+	})
+}
+
+// SetDragRegion makes rects, in client pixels of the window, act like a title
+// bar: dragging them moves the window. It replaces any previous drag region;
+// nil removes it. Together with SetFrameless this lets a page draw its own
+// title bar.
+//
+// The page lives in child windows of another process, so Windows never asks
+// the window which part of it is the title bar while the cursor is over the
+// page. There a script reports every press of the left mouse button instead,
+// and the window is moved from then on if the cursor is in a drag rect. The
+// page still receives the press, and double-clicking does not maximize the
+// window.
+func (w *WebView) SetDragRegion(rects []DragRect) {
+	rects = append([]DragRect(nil), rects...)
+	w.m.Lock()
+	w.dragRects = rects
+	addScript := len(rects) > 0 && !w.dragScript
+	if addScript {
+		w.dragScript = true
+	}
+	w.m.Unlock()
+	if !addScript {
+		return
+	}
+	js := `window.addEventListener('mousedown', function(e) {
+		if (e.button === 0) {
+			window.external.invoke(JSON.stringify({id: 0, method: ` + jsString(dragMethod) + `, params: []}));
+		}
+	}, true)`
+	w.Init(js)
+	w.onMainThread(func() { w.Eval(js) })
+}
+
+// dragMethod is the RPC method the script added by SetDragRegion calls when
+// the left mouse button is pressed over the page.
+const dragMethod = "__webview_drag__"
+
+// inDragRegion reports whether the client point x, y lies in a drag rect.
+func (w *WebView) inDragRegion(x, y int) bool {
+	w.m.Lock()
+	rects := w.dragRects
+	w.m.Unlock()
+	for _, r := range rects {
+		if x >= r.X && x < r.X+r.Width && y >= r.Y && y < r.Y+r.Height {
+			return true
+		}
+	}
+	return false
+}
+
+// dragHitTest answers WM_NCHITTEST for the point in lp when it lies in a drag
+// rect. Other points are left to the default window procedure, which keeps
+// the borders and buttons of a framed window working.
+func (w *WebView) dragHitTest(hwnd, lp uintptr) (uintptr, bool) {
+	pt := w32.Point{X: int32(int16(lp)), Y: int32(int16(lp >> 16))}
+	w32.User32ScreenToClient.Call(hwnd, uintptr(unsafe.Pointer(&pt)))
+	if !w.inDragRegion(int(pt.X), int(pt.Y)) {
+		return 0, false
+	}
+	return w32.HTCaption, true
+}
+
+// startDrag moves the window with the mouse, as a press on its title bar
+// would, if the cursor is in a drag rect.
+func (w *WebView) startDrag() {
+	var screen w32.Point
+	w32.User32GetCursorPos.Call(uintptr(unsafe.Pointer(&screen)))
+	pt := screen
+	w32.User32ScreenToClient.Call(w.HWND, uintptr(unsafe.Pointer(&pt)))
+	if !w.inDragRegion(int(pt.X), int(pt.Y)) {
+		return
+	}
+	w32.User32ReleaseCapture.Call()
+	lp := uintptr(uint16(screen.X)) | uintptr(uint16(screen.Y))<<16
+	w32.User32PostMessageW.Call(w.HWND, w32.WMNCLButtonDown, w32.HTCaption, lp)
+}
+
+// OnWindowResized sets a handler that is called on the UI thread with the new
+// size of the client area whenever the window has been resized. It replaces
+// any previous handler.
+func (w *WebView) OnWindowResized(handler func(clientWidth, clientHeight int)) {
+	w.m.Lock()
+	w.onWindowResized = handler
+	w.m.Unlock()
+}
+
+// OnWindowMoved sets a handler that is called on the UI thread with the screen
+// coordinates of the top-left corner of the client area whenever the window
+// has been moved. It replaces any previous handler.
+func (w *WebView) OnWindowMoved(handler func(x, y int)) {
+	w.m.Lock()
+	w.onWindowMoved = handler
+	w.m.Unlock()
+}
+
+// OnWindowFocusChanged sets a handler that is called on the UI thread when the
+// window becomes the active window or stops being it. Activating the window
+// does not move the keyboard focus into the page; call Focus for that. It
+// replaces any previous handler.
+func (w *WebView) OnWindowFocusChanged(handler func(focused bool)) {
+	w.m.Lock()
+	w.onFocusChanged = handler
+	w.m.Unlock()
+}
+
+// Focus gives the keyboard focus to the page, as if the user had clicked it.
+func (w *WebView) Focus() {
+	w.onMainThread(func() {
+		if err := w.Browser.MoveFocus(edge.COREWEBVIEW2_MOVE_FOCUS_REASON_PROGRAMMATIC); err != nil {
+			log.Printf("Focus: %v", err)
+		}
+	})
+}
+
+// modifierKeys maps each KeyModifier to the virtual key it holds down.
+var modifierKeys = []struct {
+	mod KeyModifier
+	vk  uint16
+}{
+	{ModCtrl, w32.VKControl},
+	{ModShift, w32.VKShift},
+	{ModAlt, w32.VKMenu},
+	{ModMeta, w32.VKLWin},
+}
+
+// SimulateKeyPress posts a key down and key up of virtualKey, a Windows
+// virtual-key code such as 0x0D for Enter, to the webview, as if typed by the
+// user. The modifier keys are pressed before and released after it. Posted
+// keys do not change the keyboard state that GetKeyState reports, so pages
+// see modifiers only as far as the browser takes them from the messages.
+func (w *WebView) SimulateKeyPress(virtualKey uint16, modifiers KeyModifier) {
+	w.onMainThread(func() {
+		hwnd := w.inputWindow()
+		down, up := uintptr(w32.WMKeyDown), uintptr(w32.WMKeyUp)
+		var flags uintptr
+		if modifiers&ModAlt != 0 {
+			down, up = w32.WMSysKeyDown, w32.WMSysKeyUp
+			flags = 1 << 29
+		}
+		for _, m := range modifierKeys {
+			if modifiers&m.mod != 0 {
+				postKey(hwnd, down, m.vk, flags)
+			}
+		}
+		postKey(hwnd, down, virtualKey, flags)
+		postKey(hwnd, up, virtualKey, flags|3<<30)
+		for i := len(modifierKeys) - 1; i >= 0; i-- {
+			if m := modifierKeys[i]; modifiers&m.mod != 0 {
+				postKey(hwnd, up, m.vk, flags|3<<30)
+			}
+		}
+	})
+}
+
+// postKey posts a keyboard message for vk with a repeat count of one, the
+// scan code of vk and the given lParam flags.
+func postKey(hwnd, msg uintptr, vk uint16, flags uintptr) {
+	scanCode, _, _ := w32.User32MapVirtualKeyW.Call(uintptr(vk), w32.MapVKVKToVSC)
+	w32.User32PostMessageW.Call(hwnd, msg, uintptr(vk), 1|(scanCode&0xFF)<<16|flags)
+}
+
+// SimulateChar posts ch to the webview as typed text, without key presses.
+func (w *WebView) SimulateChar(ch rune) {
+	w.onMainThread(func() {
+		hwnd := w.inputWindow()
+		for _, unit := range utf16.Encode([]rune{ch}) {
+			w32.User32PostMessageW.Call(hwnd, w32.WMChar, uintptr(unit), 1)
+		}
+	})
+}
+
+// SimulateMouseClick clicks button at x, y in client pixels of the window, as
+// if by the user.
+func (w *WebView) SimulateMouseClick(x, y int, button MouseButton) {
+	var down, up, key uintptr
+	switch button {
+	case MouseButtonRight:
+		down, up, key = w32.WMRButtonDown, w32.WMRButtonUp, w32.MKRButton
+	case MouseButtonMiddle:
+		down, up, key = w32.WMMButtonDown, w32.WMMButtonUp, w32.MKMButton
+	default:
+		down, up, key = w32.WMLButtonDown, w32.WMLButtonUp, w32.MKLButton
+	}
+	w.onMainThread(func() {
+		hwnd, pos := w.mouseTarget(x, y)
+		w32.User32SendMessageW.Call(hwnd, down, key, pos)
+		w32.User32SendMessageW.Call(hwnd, up, 0, pos)
+	})
+}
+
+// SimulateMouseMove moves the mouse to x, y in client pixels of the window,
+// as far as the page can tell. The cursor itself stays where it is.
+func (w *WebView) SimulateMouseMove(x, y int) {
+	w.onMainThread(func() {
+		hwnd, pos := w.mouseTarget(x, y)
+		w32.User32PostMessageW.Call(hwnd, w32.WMMouseMove, 0, pos)
+	})
+}
+
+// mouseTarget returns the window that receives mouse input at x, y in client
+// pixels of the window, and the point in its client pixels packed as the
+// lParam of a mouse message.
+func (w *WebView) mouseTarget(x, y int) (hwnd, lParam uintptr) {
+	hwnd = w.inputWindow()
+	pt := w32.Point{X: int32(x), Y: int32(y)}
+	if hwnd != w.HWND {
+		w32.User32MapWindowPoints.Call(w.HWND, hwnd, uintptr(unsafe.Pointer(&pt)), 1)
+	}
+	return hwnd, uintptr(uint16(pt.X)) | uintptr(uint16(pt.Y))<<16
+}
+
+// inputWindow returns the innermost window of the browser inside the window,
+// which receives its keyboard and mouse input, or the window itself while
+// the browser has none.
+func (w *WebView) inputWindow() uintptr {
+	hwnd := w.HWND
+	for {
+		child, _, _ := w32.User32GetWindow.Call(hwnd, w32.GWChild)
+		if child == 0 {
+			return hwnd
+		}
+		hwnd = child
+	}
+}
+
+// SetWindowIcon replaces the icon of the window, shown in its title bar and
+// the taskbar, with the .ico or .png file at iconPath.
+func (w *WebView) SetWindowIcon(iconPath string) error {
+	var big, small uintptr
+	var err error
+	switch ext := strings.ToLower(filepath.Ext(iconPath)); ext {
+	case ".ico":
+		big, small, err = loadIconFile(iconPath)
+	case ".png":
+		var data []byte
+		if data, err = os.ReadFile(iconPath); err == nil {
+			big, small, err = createIcon(data)
+		}
+	default:
+		err = fmt.Errorf("unsupported icon format %q", ext)
+	}
+	if err != nil {
+		return fmt.Errorf("SetWindowIcon: %w", err)
+	}
+	w.setIcons(big, small)
+	return nil
+}
+
+// SetWindowIconFromBytes is like SetWindowIcon but takes the content of an
+// .ico or .png file, as embedded with go:embed.
+func (w *WebView) SetWindowIconFromBytes(iconData []byte) error {
+	var big, small uintptr
+	var err error
+	switch {
+	case bytes.HasPrefix(iconData, []byte("\x89PNG")):
+		big, small, err = createIcon(iconData)
+	case bytes.HasPrefix(iconData, []byte{0, 0, 1, 0}):
+		// LoadImage reads .ico files only from disk.
+		var f *os.File
+		if f, err = os.CreateTemp("", "webview2-*.ico"); err != nil {
+			break
+		}
+		_, err = f.Write(iconData)
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err == nil {
+			big, small, err = loadIconFile(f.Name())
+		}
+		os.Remove(f.Name())
+	default:
+		err = errors.New("unsupported icon format")
+	}
+	if err != nil {
+		return fmt.Errorf("SetWindowIconFromBytes: %w", err)
+	}
+	w.setIcons(big, small)
+	return nil
+}
+
+// makeIcons calls load for the size of the large and of the small window
+// icon.
+func makeIcons(load func(size uintptr) (uintptr, error)) (big, small uintptr, err error) {
+	bigSize, _, _ := w32.User32GetSystemMetrics.Call(w32.SystemMetricsCxIcon)
+	smallSize, _, _ := w32.User32GetSystemMetrics.Call(w32.SystemMetricsCxSmIcon)
+	if big, err = load(bigSize); err != nil {
+		return 0, 0, err
+	}
+	if small, err = load(smallSize); err != nil {
+		w32.User32DestroyIcon.Call(big)
+		return 0, 0, err
+	}
+	return big, small, nil
+}
+
+// loadIconFile loads the window icons from the .ico file at path.
+func loadIconFile(path string) (big, small uintptr, err error) {
+	name, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	return makeIcons(func(size uintptr) (uintptr, error) {
+		icon, _, err := w32.User32LoadImageW.Call(
+			0, uintptr(unsafe.Pointer(name)), w32.ImageIcon, size, size, w32.LRLoadFromFile)
+		if icon == 0 {
+			return 0, err
+		}
+		return icon, nil
+	})
+}
+
+// createIcon creates the window icons from a PNG image.
+func createIcon(data []byte) (big, small uintptr, err error) {
+	if len(data) == 0 {
+		return 0, 0, errors.New("empty image")
+	}
+	return makeIcons(func(size uintptr) (uintptr, error) {
+		icon, _, err := w32.User32CreateIconFromResourceEx.Call(
+			uintptr(unsafe.Pointer(&data[0])), uintptr(len(data)),
+			1,          // fIcon
+			0x00030000, // dwVer
+			size, size, 0)
+		if icon == 0 {
+			return 0, err
+		}
+		return icon, nil
+	})
+}
+
+// setIcons makes big and small the icons of the window and destroys the ones
+// set before.
+func (w *WebView) setIcons(big, small uintptr) {
+	w.onMainThread(func() {
+		old, _, _ := w32.User32SendMessageW.Call(w.HWND, w32.WMSetIcon, w32.ICONBig, big)
+		if old != 0 {
+			w32.User32DestroyIcon.Call(old)
+		}
+		old, _, _ = w32.User32SendMessageW.Call(w.HWND, w32.WMSetIcon, w32.ICONSmall, small)
+		if old != 0 {
+			w32.User32DestroyIcon.Call(old)
+		}
+	})
+}
+
+// dpiChanged moves the window to the rectangle Windows suggests for the new
+// DPI of its monitor.
+func (w *WebView) dpiChanged(dpi uint32, suggestedRect w32.Rect) {
+	w.m.Lock()
+	handler := w.onDPIChanged
+	w.m.Unlock()
+	if handler != nil {
+		handler(dpi, suggestedRect)
+	}
+	r := suggestedRect
+	w32.User32SetWindowPos.Call(
+		w.HWND, 0, uintptr(r.Left), uintptr(r.Top), uintptr(r.Right-r.Left), uintptr(r.Bottom-r.Top),
+		w32.SWPNoZOrder|w32.SWPNoActivate)
+	w.Browser.Resize()
+}
+
+// OnDPIChanged sets a handler that is called on the UI thread when the window
+// has moved to a monitor with a different DPI, with the new DPI and the
+// window rectangle, in screen coordinates, Windows suggests for it. The window
+// is then moved to that rectangle. It replaces any previous handler.
+func (w *WebView) OnDPIChanged(handler func(dpi uint32, suggestedRect w32.Rect)) {
+	w.m.Lock()
+	w.onDPIChanged = handler
+	w.m.Unlock()
+}
+
+// backdropTypes maps a BackdropMaterial to its DWM_SYSTEMBACKDROP_TYPE.
+var backdropTypes = map[BackdropMaterial]uint32{
+	BackdropNone:    w32.DWMSBTNone,
+	BackdropMica:    w32.DWMSBTMainWindow,
+	BackdropAcrylic: w32.DWMSBTTransientWindow,
+	BackdropMicaAlt: w32.DWMSBTTabbedWindow,
+}
+
+// SetAcrylicBackdrop draws material behind the window and makes the webview
+// background transparent, so that it shows wherever the page has no
+// background of its own. BackdropNone brings back the opaque white
+// background. It returns edge.ErrNotSupported before Windows 11.
+func (w *WebView) SetAcrylicBackdrop(material BackdropMaterial) error {
+	backdrop, ok := backdropTypes[material]
+	if !ok {
+		return fmt.Errorf("SetAcrylicBackdrop: unknown material %d", material)
+	}
+	if !w32.IsWindowsVersionAtLeast(10, 0, 22000) {
+		return edge.ErrNotSupported
+	}
+	var err error
+	w.dispatchSync(func() {
+		// The frame has to extend over the whole client area for the
+		// material to show behind it.
+		margins := w32.Margins{}
+		if material != BackdropNone {
+			margins = w32.Margins{CxLeftWidth: -1, CxRightWidth: -1, CyTopHeight: -1, CyBottomHeight: -1}
+		}
+		hr, _, _ := w32.DwmapiDwmExtendFrameIntoClientArea.Call(w.HWND, uintptr(unsafe.Pointer(&margins)))
+		if int32(hr) < 0 {
+			err = syscall.Errno(hr)
+			return
+		}
+		hr, _, _ = w32.DwmapiDwmSetWindowAttribute.Call(
+			w.HWND, w32.DWMWASystemBackdropType, uintptr(unsafe.Pointer(&backdrop)), unsafe.Sizeof(backdrop))
+		if int32(hr) < 0 {
+			err = syscall.Errno(hr)
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("SetAcrylicBackdrop: %w", err)
+	}
+	if material == BackdropNone {
+		w.SetBackgroundColor(255, 255, 255, 255)
+	} else {
+		w.SetBackgroundColor(0, 0, 0, 0)
+	}
+	return nil
+}
+
+// SetDarkModeTitleBar draws the title bar and frame of the window in dark
+// colors, or in the default light ones. It needs Windows 10 1809 or later.
+func (w *WebView) SetDarkModeTitleBar(dark bool) {
+	var attribute uintptr
+	switch {
+	case w32.IsWindowsVersionAtLeast(10, 0, 18985):
+		attribute = w32.DWMWAUseImmersiveDarkMode
+	case w32.IsWindowsVersionAtLeast(10, 0, 17763):
+		// Builds before 20H1 used an undocumented attribute number.
+		attribute = w32.DWMWAUseImmersiveDarkModeBefore20H1
+	default:
+		log.Printf("SetDarkModeTitleBar: %v", edge.ErrNotSupported)
+		return
+	}
+	var value int32
+	if dark {
+		value = 1
+	}
+	w.onMainThread(func() {
+		hr, _, _ := w32.DwmapiDwmSetWindowAttribute.Call(
+			w.HWND, attribute, uintptr(unsafe.Pointer(&value)), unsafe.Sizeof(value))
+		if int32(hr) < 0 {
+			log.Printf("SetDarkModeTitleBar: %v", syscall.Errno(hr))
+		}
+	})
+}
+
+// colorizationColor returns the 0xAARRGGBB accent color DWM uses for window
+// frames.
+func colorizationColor() (uint32, error) {
+	var color uint32
+	var opaque int32
+	hr, _, _ := w32.DwmapiDwmGetColorizationColor.Call(
+		uintptr(unsafe.Pointer(&color)), uintptr(unsafe.Pointer(&opaque)))
+	if int32(hr) < 0 {
+		return 0, syscall.Errno(hr)
+	}
+	return color, nil
+}
+
+// GetSystemAccentColor returns the accent color the user picked in the
+// Windows settings. It fails if desktop composition is off.
+func GetSystemAccentColor() (r, g, b uint8, err error) {
+	color, err := colorizationColor()
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return uint8(color >> 16), uint8(color >> 8), uint8(color), nil
+}
+
+// settingChanged handles WM_SETTINGCHANGE for the setting area, such as
+// "ImmersiveColorSet".
+func (w *WebView) settingChanged(area string) {
+	if area != "ImmersiveColorSet" {
+		return
+	}
+	w.m.Lock()
+	onAccentColorChanged := w.onAccentColorChanged
+	onColorSchemeChanged := w.onColorSchemeChanged
+	w.m.Unlock()
+	if onAccentColorChanged != nil {
+		if color, err := colorizationColor(); err == nil && color != w.accentColor {
+			w.accentColor = color
+			onAccentColorChanged(uint8(color>>16), uint8(color>>8), uint8(color))
+		}
+	}
+	if onColorSchemeChanged != nil {
+		if dark := IsSystemDarkMode(); dark != w.darkMode {
+			w.darkMode = dark
+			w.Dispatch(func() {
+				onColorSchemeChanged(dark)
+			})
+		}
+	}
+}
+
+// IsSystemDarkMode reports whether the user chose the dark app mode in the
+// Windows settings.
+func IsSystemDarkMode() bool {
+	k, err := registry.OpenKey(registry.CURRENT_USER,
+		`Software\Microsoft\Windows\CurrentVersion\Themes\Personalize`, registry.QUERY_VALUE)
+	if err != nil {
+		return false
+	}
+	defer k.Close()
+	light, _, err := k.GetIntegerValue("AppsUseLightTheme")
+	return err == nil && light == 0
+}
+
+// OnSystemColorSchemeChanged sets a handler that is called from the dispatch
+// queue when the user switches Windows between light and dark app mode. It
+// replaces any previous handler.
+func (w *WebView) OnSystemColorSchemeChanged(handler func(isDark bool)) {
+	dark := IsSystemDarkMode()
+	w.onMainThread(func() {
+		w.darkMode = dark
+	})
+	w.m.Lock()
+	w.onColorSchemeChanged = handler
+	w.m.Unlock()
+}
+
+// OnAccentColorChanged sets a handler that is called on the UI thread with the
+// new accent color when the user changes it in the Windows settings. It
+// replaces any previous handler.
+func (w *WebView) OnAccentColorChanged(handler func(r, g, b uint8)) {
+	color, _ := colorizationColor()
+	w.onMainThread(func() {
+		w.accentColor = color
+	})
+	w.m.Lock()
+	w.onAccentColorChanged = handler
+	w.m.Unlock()
+}
+
+func wndproc(hwnd, msg, wp, lp uintptr) uintptr {
+	if w, ok := getWindowContext(hwnd).(*WebView); ok {
+		switch msg {
+		case w32.WMSize:
+			w.Browser.Resize()
+			w.m.Lock()
+			handler := w.onWindowResized
+			w.m.Unlock()
+			if handler != nil {
+				handler(int(lp&0xffff), int(lp>>16&0xffff))
+			}
+		case w32.WMMove:
+			w.m.Lock()
+			handler := w.onWindowMoved
+			w.m.Unlock()
+			if handler != nil {
+				handler(int(int16(lp)), int(int16(lp>>16)))
+			}
+		case w32.WMActivate:
+			w.m.Lock()
+			handler := w.onFocusChanged
+			w.m.Unlock()
+			if handler != nil {
+				handler(wp&0xffff != w32.WAInactive)
+			}
+			r, _, _ := w32.User32DefWindowProcW.Call(hwnd, msg, wp, lp)
+			return r
+		case w32.WMSettingChange:
+			w.settingChanged(w32.Utf16PtrToString((*uint16)(unsafe.Pointer(lp))))
+			r, _, _ := w32.User32DefWindowProcW.Call(hwnd, msg, wp, lp)
+			return r
+		case w32.WMDPIChanged:
+			w.dpiChanged(uint32(wp>>16&0xffff), *(*w32.Rect)(unsafe.Pointer(lp)))
+		case w32.WMClose:
+			w32.User32DestroyWindow.Call(hwnd)
+		case w32.WMDestroy:
+			w.failPendingEvals(ErrDestroyed)
+			w.unhookDevTools()
+			w.Terminate()
+		case w32.WMNCHitTest:
+			if hit, ok := w.dragHitTest(hwnd, lp); ok {
+				return hit
+			}
+			r, _, _ := w32.User32DefWindowProcW.Call(hwnd, msg, wp, lp)
+			return r
+		case w32.WMGetMinMaxInfo:
+			lpmmi := (*w32.MinMaxInfo)(unsafe.Pointer(lp))
+			if w.maxsz.X > 0 && w.maxsz.Y > 0 {
+				lpmmi.PtMaxSize = w.maxsz
+				lpmmi.PtMaxTrackSize = w.maxsz
+			}
+			if w.minsz.X > 0 && w.minsz.Y > 0 {
+				lpmmi.PtMinTrackSize = w.minsz
+			}
+		default:
+			r, _, _ := w32.User32DefWindowProcW.Call(hwnd, msg, wp, lp)
+			return r
+		}
+		return 0
+	}
+	r, _, _ := w32.User32DefWindowProcW.Call(hwnd, msg, wp, lp)
+	return r
+}
+
+func (w *WebView) Create(debug bool, window unsafe.Pointer, userDataFolder ...string) bool {
+	return w.create(compatConfig(debug, window, userDataFolder))
+}
+
+func (w *WebView) create(config *webViewConfig) bool {
+	var hinstance windows.Handle
+	windows.GetModuleHandleEx(0, nil, &hinstance)
+
+	icon := w32.ExtractIcon(os.Args[0], 0)
+
+	className, _ := windows.UTF16PtrFromString(config.windowClass)
+	wc := w32.WndClassExW{
+		CbSize:        uint32(unsafe.Sizeof(w32.WndClassExW{})),
+		HInstance:     hinstance,
+		LpszClassName: className,
+		HIcon:         windows.Handle(icon),
+		HIconSm:       windows.Handle(icon),
+		LpfnWndProc:   windows.NewCallback(wndproc),
+	}
+	w32.User32RegisterClassExW.Call(uintptr(unsafe.Pointer(&wc)))
+
+	windowName, _ := windows.UTF16PtrFromString("")
+	w.HWND, _, _ = w32.User32CreateWindowExW.Call(
+		0,
+		uintptr(unsafe.Pointer(className)),
+		uintptr(unsafe.Pointer(windowName)),
+		0xCF0000,   // WS_OVERLAPPEDWINDOW
+		0x80000000, // CW_USEDEFAULT
+		0x80000000, // CW_USEDEFAULT
+		640,
+		480,
+		uintptr(config.parent),
+		0,
+		uintptr(hinstance),
+		0,
+	)
+	setWindowContext(w.HWND, w)
+
+	w32.User32ShowWindow.Call(w.HWND, w32.SWShow)
+	w32.User32UpdateWindow.Call(w.HWND)
+	w32.User32SetFocus.Call(w.HWND)
+
+	var userDataFolder []string
+	if config.userDataFolder != "" {
+		userDataFolder = append(userDataFolder, config.userDataFolder)
+	}
+	if !w.Browser.Embed(w.HWND, userDataFolder...) {
+		return false
+	}
+	w.Browser.Resize()
+	return true
+}
+
+func (w *WebView) Destroy() {
+}
+
+func (w *WebView) Run() {
+	for w.pumpMessage() {
+	}
+	w.removeTempDataFolder()
+}
+
+// RunWithContext runs the main loop like Run, terminating it when ctx is
+// done. It returns ctx.Err() if the loop ended because of ctx and nil if it
+// ended otherwise, such as by closing the window or calling Terminate.
+func (w *WebView) RunWithContext(ctx context.Context) error {
+	stop := make(chan struct{})
+	defer close(stop)
+	terminated := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			// PostQuitMessage only reaches the calling thread's queue.
+			w.Dispatch(func() {
+				close(terminated)
+				w.Terminate()
+			})
+		case <-stop:
+		}
+	}()
+	w.Run()
+	select {
+	case <-terminated:
+		return ctx.Err()
+	default:
+		return nil
+	}
+}
+
+// removeTempDataFolder deletes the user data folder made for an incognito
+// webview. The browser processes exit shortly after the window is gone, so it
+// retries while their files are still open.
+func (w *WebView) removeTempDataFolder() {
+	if w.tempDataFolder == "" {
+		return
+	}
+	var err error
+	for i := 0; i < 50; i++ {
+		if err = os.RemoveAll(w.tempDataFolder); err == nil {
+			w.tempDataFolder = ""
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	log.Printf("Removing incognito user data folder: %v", err)
+}
+
+// IsIncognito reports whether the webview runs in an InPrivate profile, as
+// requested with WithIncognitoProfile. It reports false on runtimes that can
+// not tell.
+func (w *WebView) IsIncognito() bool {
+	var incognito bool
+	w.dispatchSync(func() {
+		incognito, _ = w.Browser.IsInPrivateModeEnabled()
+	})
+	return incognito
+}
+
+// pumpMessage waits for a single message and handles it. It returns false
+// once WM_QUIT has been received.
+func (w *WebView) pumpMessage() bool {
+	var msg w32.Msg
+	w32.User32GetMessageW.Call(
+		uintptr(unsafe.Pointer(&msg)),
+		0,
+		0,
+		0,
+	)
+	if msg.Message == w32.WMApp {
+		w.m.Lock()
+		q := w.dispatchq
+		w.dispatchq = nil
+		w.signalDispatchRoom()
+		w.m.Unlock()
+		for _, v := range q {
+			(*v)()
+		}
+	} else if msg.Message == w32.WMQuit {
+		return false
+	}
+	w32.User32TranslateMessage.Call(uintptr(unsafe.Pointer(&msg)))
+	w32.User32DispatchMessageW.Call(uintptr(unsafe.Pointer(&msg)))
+	return true
+}
+
+// isMainThread reports whether the caller runs on the UI thread.
+func (w *WebView) isMainThread() bool {
+	id, _, _ := w32.Kernel32GetCurrentThreadID.Call()
+	return id == w.mainthread
+}
+
+// dispatchSync runs f on the UI thread and waits for it to return.
+func (w *WebView) dispatchSync(f func()) {
+	if w.isMainThread() {
+		f()
+		return
+	}
+	done := make(chan struct{})
+	w.Dispatch(func() {
+		defer close(done)
+		f()
+	})
+	<-done
+}
+
+// onMainThread runs f right away when called on the UI thread and queues it
+// with Dispatch otherwise.
+func (w *WebView) onMainThread(f func()) {
+	if w.isMainThread() {
+		f()
+		return
+	}
+	w.Dispatch(f)
+}
+
+func (w *WebView) Terminate() {
+	w32.User32PostQuitMessage.Call(0)
+}
+
+func (w *WebView) Window() unsafe.Pointer {
+	return unsafe.Pointer(w.HWND)
+}
+
+func (w *WebView) Navigate(url string) {
+	w.Browser.Navigate(url)
+}
+
+// HistoryBack navigates to the previous page in the history. It does nothing
+// if there is none.
+func (w *WebView) HistoryBack() {
+	w.onMainThread(func() {
+		if err := w.Browser.GoBack(); err != nil {
+			log.Printf("HistoryBack: %v", err)
+		}
+	})
+}
+
+// HistoryForward navigates to the next page in the history. It does nothing
+// if there is none.
+func (w *WebView) HistoryForward() {
+	w.onMainThread(func() {
+		if err := w.Browser.GoForward(); err != nil {
+			log.Printf("HistoryForward: %v", err)
+		}
+	})
+}
+
+// CanGoBack reports whether HistoryBack would navigate anywhere.
+func (w *WebView) CanGoBack() bool {
+	w.m.Lock()
+	defer w.m.Unlock()
+	return w.canGoBack
+}
+
+// CanGoForward reports whether HistoryForward would navigate anywhere.
+func (w *WebView) CanGoForward() bool {
+	w.m.Lock()
+	defer w.m.Unlock()
+	return w.canGoForward
+}
+
+// OnHistoryChanged sets a handler that is called on the UI thread whenever
+// the navigation history changes. It replaces any previous handler.
+func (w *WebView) OnHistoryChanged(handler func(canGoBack, canGoForward bool)) {
+	w.m.Lock()
+	w.onHistoryChanged = handler
+	w.m.Unlock()
+}
+
+// Reload reloads the current page.
+func (w *WebView) Reload() {
+	w.onMainThread(func() {
+		if err := w.Browser.Reload(); err != nil {
+			log.Printf("Reload: %v", err)
+		}
+	})
+}
+
+// Stop cancels any pending navigation and stops loading the current page.
+func (w *WebView) Stop() {
+	w.onMainThread(func() {
+		if err := w.Browser.Stop(); err != nil {
+			log.Printf("Stop: %v", err)
+		}
+	})
+}
+
+// GetCurrentURL returns the URL of the document the webview is showing, or
+// "about:blank" before anything has been loaded.
+func (w *WebView) GetCurrentURL() string {
+	var url string
+	w.dispatchSync(func() {
+		url, _ = w.Browser.GetSource()
+	})
+	if url == "" {
+		return "about:blank"
+	}
+	return url
+}
+
+// NavigateToString loads html directly, without a server or temporary file.
+// Relative URLs in the document resolve against about:blank; use
+// NavigateToStringWithBase if the page refers to other assets.
+func (w *WebView) NavigateToString(html string) {
+	w.onMainThread(func() {
+		if err := w.Browser.NavigateToString(html); err != nil {
+			log.Printf("NavigateToString: %v", err)
+		}
+	})
+}
+
+// GetCurrentTitle returns the title of the loaded document. The title is
+// cached as it changes, so this does not have to wait for the UI thread. It is
+// empty while a navigation is pending.
+func (w *WebView) GetCurrentTitle() string {
+	w.m.Lock()
+	defer w.m.Unlock()
+	return w.title
+}
+
+// NavigateToStringWithBase is like NavigateToString but resolves relative
+// URLs in content against baseURL by adding a <base> element at the start of
+// its head, or after its doctype if it has no head element.
+func (w *WebView) NavigateToStringWithBase(content, baseURL string) {
+	w.NavigateToString(insertBase(content, `<base href="`+html.EscapeString(baseURL)+`">`))
+}
+
+// insertBase inserts the base element base into the HTML document content
+// without putting anything in front of its doctype, which would switch the
+// page to quirks mode.
+func insertBase(content, base string) string {
+	// Only ASCII is lowered, so that indices in lower match content.
+	lower := strings.Map(func(r rune) rune {
+		if 'A' <= r && r <= 'Z' {
+			return r + 'a' - 'A'
+		}
+		return r
+	}, content)
+	for i := 0; ; {
+		j := strings.Index(lower[i:], "<head")
+		if j < 0 {
+			break
+		}
+		i += j + len("<head")
+		if i < len(lower) && strings.IndexByte(">/ \t\r\n\f", lower[i]) >= 0 {
+			if end := strings.IndexByte(lower[i:], '>'); end >= 0 {
+				i += end + 1
+				return content[:i] + base + content[i:]
+			}
+			break
+		}
+	}
+	trimmed := strings.TrimLeft(lower, " \t\r\n\f")
+	if strings.HasPrefix(trimmed, "<!doctype") {
+		i := len(lower) - len(trimmed)
+		if end := strings.IndexByte(lower[i:], '>'); end >= 0 {
+			i += end + 1
+			return content[:i] + base + content[i:]
+		}
+	}
+	return base + content
+}
+
+// NavigateWithPostData navigates to url with a POST request carrying body as
+// its content, labelled with contentType.
+func (w *WebView) NavigateWithPostData(url, contentType string, body []byte) {
+	w.onMainThread(func() {
+		headers := "Content-Type: " + contentType
+		if err := w.Browser.NavigateWithWebResourceRequest(url, "POST", body, headers); err != nil {
+			log.Printf("NavigateWithPostData: %v", err)
+		}
+	})
+}
+
+// NavigateWithHeaders navigates to url with a GET request that carries the
+// given additional request headers.
+func (w *WebView) NavigateWithHeaders(url string, headers map[string]string) {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	lines := make([]string, 0, len(names))
+	for _, name := range names {
+		lines = append(lines, name+": "+headers[name])
+	}
+
+	w.onMainThread(func() {
+		if err := w.Browser.NavigateWithWebResourceRequest(url, "GET", nil, strings.Join(lines, "\r\n")); err != nil {
+			log.Printf("NavigateWithHeaders: %v", err)
+		}
+	})
+}
+
+// SetTitle sets the title of the window. It fails if title contains a NUL
+// byte or is longer than a window title can be.
+func (w *WebView) SetTitle(title string) error {
+	_title, err := windows.UTF16FromString(title)
+	if err != nil {
+		return err
+	}
+	if len(_title)-1 > maxTitleLength {
+		return ErrTitleTooLong
+	}
+	w32.User32SetWindowTextW.Call(w.HWND, uintptr(unsafe.Pointer(&_title[0])))
+	return nil
+}
+
+// GetWindowTitle returns the title the window shows, whether it was set with
+// SetTitle or through AutoFollowTitle.
+func (w *WebView) GetWindowTitle() string {
+	n, _, _ := w32.User32GetWindowTextLengthW.Call(w.HWND)
+	if n == 0 {
+		return ""
+	}
+	buf := make([]uint16, n+1)
+	n, _, _ = w32.User32GetWindowTextW.Call(w.HWND, uintptr(unsafe.Pointer(&buf[0])), uintptr(len(buf)))
+	return windows.UTF16ToString(buf[:n])
+}
+
+func (w *WebView) SetSize(width int, height int, hints Hint) {
+	if hints == HintFullscreen {
+		w.setFullscreen(true)
+		return
+	}
+	if w.fullscreen {
+		w.setFullscreen(false)
+	}
+
+	index := w32.GWLStyle
+	style, _, _ := w32.User32GetWindowLongPtrW.Call(w.HWND, uintptr(index))
+	if hints == HintFixed {
+		style &^= (w32.WSThickFrame | w32.WSMaximizeBox)
+	} else {
+		style |= (w32.WSThickFrame | w32.WSMaximizeBox)
+	}
+	w32.User32SetWindowLongPtrW.Call(w.HWND, uintptr(index), style)
+
+	if hints == HintMax {
+		w.maxsz.X = int32(width)
+		w.maxsz.Y = int32(height)
+	} else if hints == HintMin {
+		w.minsz.X = int32(width)
+		w.minsz.Y = int32(height)
+	} else if hints == HintCenter {
+		scrWidth, _, _ := w32.User32GetSystemMetrics.Call(w32.SystemMetricsCxScreen)
+		scrHeight, _, _ := w32.User32GetSystemMetrics.Call(w32.SystemMetricsCyScreen)
+		rect := new(w32.Rect)
+		ret, _, _ := syscall.Syscall(w32.User32GetWindowRect.Addr(), 2,
+			w.HWND,
+			uintptr(unsafe.Pointer(rect)),
+			0)
+		if ret == 0 {
+			return
+		}
+		rect.Left = int32((int(scrWidth) - width) / 2)
 		rect.Top = int32((int(scrHeight) - height) / 2)
 		_, _, _ = w32.User32MoveWindow.Call(w.HWND,
 			uintptr(rect.Left), uintptr(rect.Top),
@@ -338,23 +3653,1257 @@ func (w *WebView) SetSize(width int, height int, hints Hint) {
 	}
 }
 
-func (w *WebView) Init(js string) {
-	w.Browser.Init(js)
+// Minimize minimizes the window to the taskbar.
+func (w *WebView) Minimize() {
+	w.onMainThread(func() {
+		w32.User32ShowWindow.Call(w.HWND, w32.SWMinimize)
+	})
+}
+
+// Maximize makes the window fill the work area of its monitor.
+func (w *WebView) Maximize() {
+	w.onMainThread(func() {
+		w32.User32ShowWindow.Call(w.HWND, w32.SWMaximize)
+	})
+}
+
+// Restore brings a minimized or maximized window back to its previous size
+// and position.
+func (w *WebView) Restore() {
+	w.onMainThread(func() {
+		w32.User32ShowWindow.Call(w.HWND, w32.SWRestore)
+	})
+}
+
+// IsMaximized reports whether the window is maximized.
+func (w *WebView) IsMaximized() bool {
+	ret, _, _ := w32.User32IsZoomed.Call(w.HWND)
+	return ret != 0
+}
+
+// SetWindowPosition moves the top-left corner of the window to x, y in screen
+// coordinates. Monitors left of or above the primary monitor have negative
+// coordinates.
+func (w *WebView) SetWindowPosition(x, y int) {
+	w.onMainThread(func() {
+		w32.User32SetWindowPos.Call(
+			w.HWND, 0, uintptr(x), uintptr(y), 0, 0,
+			w32.SWPNoSize|w32.SWPNoZOrder|w32.SWPNoActivate)
+	})
+}
+
+// GetWindowPosition returns the screen coordinates of the top-left corner of
+// the window.
+func (w *WebView) GetWindowPosition() (x, y int) {
+	var r w32.Rect
+	w32.User32GetWindowRect.Call(w.HWND, uintptr(unsafe.Pointer(&r)))
+	return int(r.Left), int(r.Top)
+}
+
+// HideWindow hides the window, such as to minimize it to the system tray,
+// and lets WebView2 free the resources it uses to render the page. The
+// webview keeps running and Run keeps processing its messages.
+func (w *WebView) HideWindow() {
+	w.onMainThread(func() {
+		w32.User32ShowWindow.Call(w.HWND, w32.SWHide)
+		if err := w.Browser.Hide(); err != nil {
+			log.Printf("HideWindow: %v", err)
+		}
+	})
+}
+
+// ShowWindow shows a window hidden with HideWindow again.
+func (w *WebView) ShowWindow() {
+	w.onMainThread(func() {
+		w32.User32ShowWindow.Call(w.HWND, w32.SWShow)
+		if err := w.Browser.Show(); err != nil {
+			log.Printf("ShowWindow: %v", err)
+		}
+		w.Browser.Resize()
+	})
+}
+
+// SetAlwaysOnTop keeps the window above all windows that are not topmost
+// themselves, even when it is not active, or makes it a normal window again.
+func (w *WebView) SetAlwaysOnTop(topmost bool) {
+	after := w32.HWNDNoTopmost
+	if topmost {
+		after = w32.HWNDTopmost
+	}
+	w.onMainThread(func() {
+		w32.User32SetWindowPos.Call(
+			w.HWND, after, 0, 0, 0, 0,
+			w32.SWPNoMove|w32.SWPNoSize|w32.SWPNoActivate)
+	})
+}
+
+// IsAlwaysOnTop reports whether the window was made topmost with
+// SetAlwaysOnTop.
+func (w *WebView) IsAlwaysOnTop() bool {
+	index := w32.GWLExStyle
+	style, _, _ := w32.User32GetWindowLongPtrW.Call(w.HWND, uintptr(index))
+	return style&w32.WSExTopmost != 0
+}
+
+// SetFrameless removes the title bar and border of the window, for pages that
+// draw their own, or brings back the style the window had before.
+func (w *WebView) SetFrameless(frameless bool) {
+	w.onMainThread(func() {
+		if frameless == w.frameless {
+			return
+		}
+		index := w32.GWLStyle
+		if frameless {
+			w.framelessStyle, _, _ = w32.User32GetWindowLongPtrW.Call(w.HWND, uintptr(index))
+			style := w.framelessStyle&^w32.WSOverlappedWindow | w32.WSPopup
+			w32.User32SetWindowLongPtrW.Call(w.HWND, uintptr(index), style)
+		} else {
+			w32.User32SetWindowLongPtrW.Call(w.HWND, uintptr(index), w.framelessStyle)
+		}
+		w32.User32SetWindowPos.Call(
+			w.HWND, 0, 0, 0, 0, 0,
+			w32.SWPNoZOrder|w32.SWPNoActivate|w32.SWPNoMove|w32.SWPNoSize|w32.SWPFrameChanged)
+		w.frameless = frameless
+		w.Browser.Resize()
+	})
+}
+
+// SetOpacity makes the whole window, frame included, translucent. alpha goes
+// from 0, fully transparent, to 1, opaque, and is clamped to that range.
+func (w *WebView) SetOpacity(alpha float64) {
+	if alpha < 0 {
+		alpha = 0
+	} else if alpha > 1 {
+		alpha = 1
+	}
+	w.onMainThread(func() {
+		index := w32.GWLExStyle
+		style, _, _ := w32.User32GetWindowLongPtrW.Call(w.HWND, uintptr(index))
+		if alpha == 1 {
+			// An opaque window does not need to be composited as layered.
+			w32.User32SetWindowLongPtrW.Call(w.HWND, uintptr(index), style&^w32.WSExLayered)
+			return
+		}
+		w32.User32SetWindowLongPtrW.Call(w.HWND, uintptr(index), style|w32.WSExLayered)
+		w32.User32SetLayeredWindowAttributes.Call(w.HWND, 0, uintptr(byte(alpha*255)), w32.LWAAlpha)
+	})
+}
+
+// GetOpacity returns the opacity set with SetOpacity, from 0 to 1.
+func (w *WebView) GetOpacity() float64 {
+	var alpha byte
+	var flags uint32
+	ret, _, _ := w32.User32GetLayeredWindowAttributes.Call(
+		w.HWND, 0, uintptr(unsafe.Pointer(&alpha)), uintptr(unsafe.Pointer(&flags)))
+	if ret == 0 || flags&w32.LWAAlpha == 0 {
+		return 1
+	}
+	return float64(alpha) / 255
+}
+
+func (w *WebView) containsFullScreenElementChanged(sender *edge.ICoreWebView2) {
+	fullscreen, err := sender.GetContainsFullScreenElement()
+	if err != nil {
+		log.Printf("ContainsFullScreenElementChanged: %v", err)
+		return
+	}
+	w.setFullscreen(fullscreen)
+}
+
+// SetFullscreen makes the window cover the whole screen without a frame, or
+// brings back the previous style and size. Pages going fullscreen through
+// the HTML5 Fullscreen API do this automatically.
+func (w *WebView) SetFullscreen(fullscreen bool) {
+	w.onMainThread(func() {
+		w.setFullscreen(fullscreen)
+	})
+}
+
+func (w *WebView) setFullscreen(fullscreen bool) {
+	if fullscreen == w.fullscreen {
+		return
+	}
+	index := w32.GWLStyle
+	if fullscreen {
+		w.savedStyle, _, _ = w32.User32GetWindowLongPtrW.Call(w.HWND, uintptr(index))
+		_, _, _ = syscall.Syscall(w32.User32GetWindowRect.Addr(), 2,
+			w.HWND,
+			uintptr(unsafe.Pointer(&w.savedRect)),
+			0)
+		style := w.savedStyle&^w32.WSOverlappedWindow | w32.WSPopup
+		w32.User32SetWindowLongPtrW.Call(w.HWND, uintptr(index), style)
+		r, ok := w32.GetMonitorRect(w.HWND)
+		if !ok {
+			scrWidth, _, _ := w32.User32GetSystemMetrics.Call(w32.SystemMetricsCxScreen)
+			scrHeight, _, _ := w32.User32GetSystemMetrics.Call(w32.SystemMetricsCyScreen)
+			r = w32.Rect{Right: int32(scrWidth), Bottom: int32(scrHeight)}
+		}
+		w32.User32SetWindowPos.Call(
+			w.HWND, 0, uintptr(r.Left), uintptr(r.Top), uintptr(r.Right-r.Left), uintptr(r.Bottom-r.Top),
+			w32.SWPNoZOrder|w32.SWPFrameChanged)
+	} else {
+		w32.User32SetWindowLongPtrW.Call(w.HWND, uintptr(index), w.savedStyle)
+		r := w.savedRect
+		w32.User32SetWindowPos.Call(
+			w.HWND, 0, uintptr(r.Left), uintptr(r.Top), uintptr(r.Right-r.Left), uintptr(r.Bottom-r.Top),
+			w32.SWPNoZOrder|w32.SWPFrameChanged)
+	}
+	w.Browser.Resize()
+
+	w.m.Lock()
+	w.fullscreen = fullscreen
+	handler := w.onFullscreenChanged
+	w.m.Unlock()
+	if handler != nil {
+		handler(fullscreen)
+	}
+}
+
+// OnFullscreenChanged sets a handler that is called on the UI thread after the
+// window entered or left fullscreen, either through SetFullscreen or because
+// the page used the HTML5 Fullscreen API. It replaces any previous handler.
+func (w *WebView) OnFullscreenChanged(handler func(isFullscreen bool)) {
+	w.m.Lock()
+	w.onFullscreenChanged = handler
+	w.m.Unlock()
+}
+
+// Zoom factors accepted by SetZoomFactor.
+const (
+	minZoomFactor = 0.1
+	maxZoomFactor = 10.0
+)
+
+// SetZoomFactor zooms the page by factor, where 1.0 is the original size.
+// factor is clamped to the range [0.1, 10.0].
+func (w *WebView) SetZoomFactor(factor float64) {
+	if factor < minZoomFactor {
+		factor = minZoomFactor
+	} else if factor > maxZoomFactor {
+		factor = maxZoomFactor
+	}
+	w.onMainThread(func() {
+		if err := w.Browser.PutZoomFactor(factor); err != nil {
+			log.Printf("SetZoomFactor: %v", err)
+		}
+	})
+}
+
+// GetZoomFactor returns the current zoom factor of the page.
+func (w *WebView) GetZoomFactor() float64 {
+	factor := 1.0
+	w.dispatchSync(func() {
+		if f, err := w.Browser.GetZoomFactor(); err == nil {
+			factor = f
+		}
+	})
+	return factor
+}
+
+// SetAudioMuted mutes or unmutes all audio of the webview.
+func (w *WebView) SetAudioMuted(muted bool) {
+	w.onMainThread(func() {
+		if err := w.Browser.PutIsMuted(muted); err != nil {
+			log.Printf("SetAudioMuted: %v", err)
+		}
+	})
+}
+
+// GetAudioMuted reports whether the audio of the webview is muted.
+func (w *WebView) GetAudioMuted() bool {
+	var muted bool
+	w.dispatchSync(func() {
+		muted, _ = w.Browser.GetIsMuted()
+	})
+	return muted
+}
+
+func (w *WebView) audioStateChanged(sender *edge.ICoreWebView2) {
+	w.m.Lock()
+	handler := w.onAudioStateChanged
+	w.m.Unlock()
+	if handler == nil {
+		return
+	}
+	audible, err := w.Browser.GetIsDocumentPlayingAudio()
+	if err != nil {
+		log.Printf("AudioStateChanged: %v", err)
+		return
+	}
+	muted, err := w.Browser.GetIsMuted()
+	if err != nil {
+		log.Printf("AudioStateChanged: %v", err)
+		return
+	}
+	handler(audible, muted)
+}
+
+// OnAudioStateChanged sets a handler that is called on the UI thread when the
+// page starts or stops playing audio, or is muted or unmuted. audible reports
+// whether the page is playing audio, even while muted. It replaces any
+// previous handler.
+func (w *WebView) OnAudioStateChanged(handler func(audible, muted bool)) {
+	w.m.Lock()
+	w.onAudioStateChanged = handler
+	w.m.Unlock()
+}
+
+// OnZoomFactorChanged sets a handler that is called on the UI thread when the
+// zoom factor changes, either through SetZoomFactor or by the user. It
+// replaces any previous handler.
+func (w *WebView) OnZoomFactorChanged(handler func(factor float64)) {
+	w.m.Lock()
+	w.onZoomFactorChanged = handler
+	w.m.Unlock()
+}
+
+func (w *WebView) Init(js string) {
+	w.Browser.Init(js)
+}
+
+// InitWithID is like Init, but returns an ID that can be passed to
+// RemoveInitScript to stop injecting js into new documents.
+func (w *WebView) InitWithID(js string) (id string, err error) {
+	id, err = w.addInitScript(js)
+	if err != nil {
+		return "", err
+	}
+
+	w.m.Lock()
+	w.initScripts[id] = js
+	w.m.Unlock()
+	return id, nil
+}
+
+// addInitScript injects js into new documents and returns the ID WebView2
+// gave the script.
+func (w *WebView) addInitScript(js string) (id string, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), asyncTimeout)
+	defer cancel()
+
+	var addErr error
+	err = w.await(ctx, func(done func()) {
+		err := w.Browser.AddScriptToExecuteOnDocumentCreated(js, func(scriptID string, err error) {
+			id, addErr = scriptID, err
+			done()
+		})
+		if err != nil {
+			addErr = err
+			done()
+		}
+	})
+	if err != nil {
+		return "", err
+	}
+	if addErr != nil {
+		return "", addErr
+	}
+	return id, nil
+}
+
+// RemoveInitScript stops injecting the script registered by InitWithID under
+// id into new documents. Documents that are already loaded are unaffected.
+func (w *WebView) RemoveInitScript(id string) error {
+	w.m.Lock()
+	_, ok := w.initScripts[id]
+	delete(w.initScripts, id)
+	w.m.Unlock()
+	if !ok {
+		return errors.New("init script not found")
+	}
+
+	var err error
+	w.dispatchSync(func() {
+		err = w.Browser.RemoveScriptToExecuteOnDocumentCreated(id)
+	})
+	return err
+}
+
+// ListInitScriptIDs returns the IDs of the scripts registered with
+// InitWithID, sorted lexicographically.
+func (w *WebView) ListInitScriptIDs() []string {
+	w.m.Lock()
+	ids := make([]string, 0, len(w.initScripts))
+	for id := range w.initScripts {
+		ids = append(ids, id)
+	}
+	w.m.Unlock()
+	sort.Strings(ids)
+	return ids
+}
+
+// GetInitScript returns the source of the script registered with InitWithID
+// under id, or an empty string if there is none.
+func (w *WebView) GetInitScript(id string) string {
+	w.m.Lock()
+	defer w.m.Unlock()
+	return w.initScripts[id]
+}
+
+// ClearInitScripts removes all scripts registered with InitWithID. As with
+// RemoveInitScript, documents that are already loaded are unaffected.
+func (w *WebView) ClearInitScripts() {
+	w.m.Lock()
+	scripts := w.initScripts
+	w.initScripts = map[string]string{}
+	w.m.Unlock()
+
+	w.dispatchSync(func() {
+		for id := range scripts {
+			if err := w.Browser.RemoveScriptToExecuteOnDocumentCreated(id); err != nil {
+				log.Printf("ClearInitScripts: %v", err)
+			}
+		}
+	})
+}
+
+// SetContentSecurityPolicy applies the Content Security Policy csp to every
+// page loaded afterwards. It adds csp as a Content-Security-Policy header to
+// the responses the application gives, through SetCustomSchemeHandler or
+// OnWebResourceRequested, and as a meta tag to all documents, which is the
+// only way to reach responses coming from the network. The meta tag only
+// restricts what the page loads after it is parsed; the header covers the
+// whole document. An empty csp removes the policy.
+func (w *WebView) SetContentSecurityPolicy(csp string) {
+	w.m.Lock()
+	w.csp = csp
+	oldID := w.cspScriptID
+	w.cspScriptID = ""
+	w.m.Unlock()
+
+	remove := func(id string) {
+		w.dispatchSync(func() {
+			if err := w.Browser.RemoveScriptToExecuteOnDocumentCreated(id); err != nil {
+				log.Printf("SetContentSecurityPolicy: %v", err)
+			}
+		})
+	}
+	if oldID != "" {
+		remove(oldID)
+	}
+	if csp == "" {
+		return
+	}
+	id, err := w.addInitScript("(function(){var csp=" + jsString(csp) + ";" + `
+		function add() {
+			var meta = document.createElement('meta');
+			meta.httpEquiv = 'Content-Security-Policy';
+			meta.content = csp;
+			document.head.insertBefore(meta, document.head.firstChild);
+		}
+		if (document.head) {
+			add();
+			return;
+		}
+		new MutationObserver(function(_, observer) {
+			if (document.head) {
+				observer.disconnect();
+				add();
+			}
+		}).observe(document, {childList: true, subtree: true});
+	})()`)
+	if err != nil {
+		log.Printf("SetContentSecurityPolicy: %v", err)
+		return
+	}
+	// A concurrent call may have changed the policy or registered its own
+	// script while this one was being added.
+	w.m.Lock()
+	stale := w.csp != csp || w.cspScriptID != ""
+	if !stale {
+		w.cspScriptID = id
+	}
+	w.m.Unlock()
+	if stale {
+		remove(id)
+	}
+}
+
+// InjectCSS adds css as a stylesheet to the current page and to every page
+// loaded afterwards, and returns an ID that can be passed to RemoveCSS. If
+// the stylesheet cannot be added to the current page, it is not added to later
+// pages either and the error is returned.
+func (w *WebView) InjectCSS(css string) (id string, err error) {
+	id, err = newUUID()
+	if err != nil {
+		return "", err
+	}
+	js := "(function(){var id=" + jsString(id) + ",css=" + jsString(css) + ";" + `
+		function add() {
+			if (document.getElementById(id)) return;
+			var style = document.createElement('style');
+			style.id = id;
+			style.textContent = css;
+			(document.head || document.documentElement).appendChild(style);
+		}
+		if (document.readyState === 'loading') {
+			document.addEventListener('DOMContentLoaded', add);
+		} else {
+			add();
+		}
+	})()`
+	scriptID, err := w.addInitScript(js)
+	if err != nil {
+		return "", err
+	}
+
+	w.m.Lock()
+	w.injectedCSS[id] = scriptID
+	w.m.Unlock()
+	if _, err := w.EvalWithResult(js); err != nil {
+		w.m.Lock()
+		delete(w.injectedCSS, id)
+		w.m.Unlock()
+		w.dispatchSync(func() {
+			if err := w.Browser.RemoveScriptToExecuteOnDocumentCreated(scriptID); err != nil {
+				log.Printf("InjectCSS: %v", err)
+			}
+		})
+		return "", err
+	}
+	return id, nil
+}
+
+// RemoveCSS removes the stylesheet added by InjectCSS under id from the
+// current page and stops adding it to new pages.
+func (w *WebView) RemoveCSS(id string) error {
+	w.m.Lock()
+	scriptID, ok := w.injectedCSS[id]
+	delete(w.injectedCSS, id)
+	w.m.Unlock()
+	if !ok {
+		return errors.New("stylesheet not found")
+	}
+
+	var err error
+	w.dispatchSync(func() {
+		err = w.Browser.RemoveScriptToExecuteOnDocumentCreated(scriptID)
+	})
+	if err != nil {
+		return err
+	}
+	_, err = w.EvalWithResult("(function(){var style=document.getElementById(" + jsString(id) + ");if(style)style.remove()})()")
+	return err
+}
+
+// newUUID returns a random version 4 UUID.
+func newUUID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
 }
 
 func (w *WebView) Eval(js string) {
 	w.Browser.Eval(js)
 }
 
+// asyncTimeout bounds how long blocking calls such as EvalWithResult wait for
+// WebView2 to complete them.
+const asyncTimeout = 30 * time.Second
+
+// evalScript wraps js so that its completion value and any exception it
+// throws can be told apart in the JSON result of ExecuteScript.
+func evalScript(js string) string {
+	return "(function(){try{return [true,(0,eval)(" + jsString(js) + ")]}" +
+		"catch(e){return [false,String(e&&e.message||e)]}})()"
+}
+
+// evalResult unpacks the JSON produced by a script wrapped with evalScript.
+func evalResult(result string) (string, error) {
+	var res []json.RawMessage
+	if err := json.Unmarshal([]byte(result), &res); err != nil || len(res) != 2 {
+		return "", errors.New("unexpected script result: " + result)
+	}
+	if string(res[0]) != "true" {
+		var msg string
+		json.Unmarshal(res[1], &msg)
+		return "", errors.New("javascript exception: " + msg)
+	}
+	return string(res[1]), nil
+}
+
+// EvalWithResult evaluates js and blocks until it has finished, returning the
+// JSON-encoded value of the last expression. If the script throws, the
+// returned error carries the exception message. It gives up after 30 seconds.
+func (w *WebView) EvalWithResult(js string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), asyncTimeout)
+	defer cancel()
+	return w.EvalWithResultContext(ctx, js)
+}
+
+// EvalWithResultContext is like EvalWithResult but waits until ctx is done
+// instead of using the default timeout. It may be called from any goroutine,
+// including the UI thread, where it keeps the message loop running while
+// waiting.
+func (w *WebView) EvalWithResultContext(ctx context.Context, js string) (string, error) {
+	var value string
+	var evalErr error
+	err := w.await(ctx, func(done func()) {
+		err := w.Browser.ExecuteScript(evalScript(js), func(res string, err error) {
+			if err == nil {
+				res, err = evalResult(res)
+			}
+			value, evalErr = res, err
+			done()
+		})
+		if err != nil {
+			evalErr = err
+			done()
+		}
+	})
+	if err != nil {
+		return "", err
+	}
+	return value, evalErr
+}
+
+// ScrollToPosition scrolls the page so that x, y in CSS pixels is its
+// top-left corner.
+func (w *WebView) ScrollToPosition(x, y int) {
+	w.onMainThread(func() {
+		w.Eval(fmt.Sprintf("window.scrollTo(%d,%d)", x, y))
+	})
+}
+
+// GetScrollPosition returns how far the page is scrolled, in CSS pixels. It
+// returns 0, 0 if the page could not be asked.
+func (w *WebView) GetScrollPosition() (x, y int) {
+	res, err := w.EvalWithResult("JSON.stringify([window.scrollX,window.scrollY])")
+	if err != nil {
+		log.Printf("GetScrollPosition: %v", err)
+		return 0, 0
+	}
+	var pos string
+	var xy [2]float64
+	if err := json.Unmarshal([]byte(res), &pos); err != nil || json.Unmarshal([]byte(pos), &xy) != nil {
+		log.Printf("GetScrollPosition: unexpected result %s", res)
+		return 0, 0
+	}
+	return int(xy[0]), int(xy[1])
+}
+
+// ScrollToElement scrolls the first element matching the CSS selector into
+// view. It returns ErrElementNotFound if no element matches.
+func (w *WebView) ScrollToElement(selector string) error {
+	res, err := w.EvalWithResult("(function(){var e=document.querySelector(" + jsString(selector) + ");" +
+		"if(!e)return false;e.scrollIntoView();return true})()")
+	if err != nil {
+		return err
+	}
+	if res != "true" {
+		return ErrElementNotFound
+	}
+	return nil
+}
+
+// querySelectorScript reads properties of the first element matching a
+// selector. A property ending in "()" is called as a method.
+const querySelectorScript = `(function(selector, properties) {
+	var e = document.querySelector(selector);
+	if (!e) return null;
+	var res = {};
+	properties.forEach(function(p) {
+		var v = /\(\)$/.test(p) ? e[p.slice(0, -2)]() : e[p];
+		res[p] = v && typeof v.toJSON === 'function' ? v.toJSON() : v;
+	});
+	return res;
+})`
+
+// QuerySelector returns the given properties of the first element matching
+// the CSS selector, such as "innerText", "value" or "checked", keyed by
+// property. Properties ending in "()", like "getBoundingClientRect()", are
+// called and their results returned. Values are decoded from JSON as by
+// encoding/json. It returns ErrElementNotFound if no element matches.
+func (w *WebView) QuerySelector(selector string, properties []string) (map[string]interface{}, error) {
+	if properties == nil {
+		properties = []string{}
+	}
+	res, err := w.EvalWithResult(querySelectorScript + "(" + jsString(selector) + "," + jsString(properties) + ")")
+	if err != nil {
+		return nil, err
+	}
+	if res == "null" {
+		return nil, ErrElementNotFound
+	}
+	var values map[string]interface{}
+	if err := json.Unmarshal([]byte(res), &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// ExecuteCDPCommand runs the Chrome DevTools Protocol method, such as
+// "Page.captureSnapshot", with params and blocks until it returns its result.
+// A nil params sends no parameters. It gives up after 30 seconds.
+func (w *WebView) ExecuteCDPCommand(method string, params json.RawMessage) (json.RawMessage, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), asyncTimeout)
+	defer cancel()
+	return w.ExecuteCDPCommandContext(ctx, method, params)
+}
+
+// ExecuteCDPCommandContext is like ExecuteCDPCommand but waits until ctx is
+// done instead of using the default timeout.
+func (w *WebView) ExecuteCDPCommandContext(ctx context.Context, method string, params json.RawMessage) (json.RawMessage, error) {
+	if len(params) == 0 {
+		params = json.RawMessage("{}")
+	}
+	var result json.RawMessage
+	var cdpErr error
+	err := w.await(ctx, func(done func()) {
+		err := w.Browser.CallDevToolsProtocolMethod(method, string(params), func(res string, err error) {
+			result, cdpErr = json.RawMessage(res), err
+			done()
+		})
+		if err != nil {
+			cdpErr = err
+			done()
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if cdpErr != nil {
+		return nil, fmt.Errorf("%s: %w", method, cdpErr)
+	}
+	return result, nil
+}
+
+// SubscribeCDPEvent calls handler from the dispatch queue with the parameters
+// of every Chrome DevTools Protocol event named event, such as
+// "Network.requestWillBeSent". Events of a domain are only sent once it has
+// been enabled, for example with ExecuteCDPCommand("Network.enable", nil).
+// Every subscription is independent; cancel removes only this one.
+func (w *WebView) SubscribeCDPEvent(event string, handler func(params json.RawMessage)) (cancel func(), err error) {
+	var remove func()
+	w.dispatchSync(func() {
+		remove, err = w.Browser.AddDevToolsProtocolEventReceived(event, func(params string) {
+			w.Dispatch(func() {
+				handler(json.RawMessage(params))
+			})
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", event, err)
+	}
+	return func() {
+		w.onMainThread(remove)
+	}, nil
+}
+
+// OverrideGeolocation makes the page see lat, lon in degrees, accurate to
+// accuracy meters, as its position instead of asking the location service.
+// Pages still need the geolocation permission to read it. It returns
+// ErrWebViewNotReady until the first navigation has completed.
+func (w *WebView) OverrideGeolocation(lat, lon, accuracy float64) error {
+	finite := func(f float64) bool { return !math.IsNaN(f) && !math.IsInf(f, 0) }
+	switch {
+	case !finite(lat) || !finite(lon) || !finite(accuracy):
+		return errors.New("position must be finite")
+	case lat < -90 || lat > 90:
+		return errors.New("latitude out of range")
+	case lon < -180 || lon > 180:
+		return errors.New("longitude out of range")
+	case !(accuracy > 0):
+		return errors.New("accuracy must be positive")
+	}
+	if !w.hasNavigated() {
+		return ErrWebViewNotReady
+	}
+	params, err := json.Marshal(map[string]float64{
+		"latitude":  lat,
+		"longitude": lon,
+		"accuracy":  accuracy,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = w.ExecuteCDPCommand("Emulation.setGeolocationOverride", params)
+	return err
+}
+
+// ClearGeolocationOverride gives pages their real position again after
+// OverrideGeolocation. It returns ErrWebViewNotReady until the first
+// navigation has completed.
+func (w *WebView) ClearGeolocationOverride() error {
+	if !w.hasNavigated() {
+		return ErrWebViewNotReady
+	}
+	_, err := w.ExecuteCDPCommand("Emulation.clearGeolocationOverride", nil)
+	return err
+}
+
+// hasNavigated reports whether the first navigation has completed.
+func (w *WebView) hasNavigated() bool {
+	w.m.Lock()
+	defer w.m.Unlock()
+	return w.navigated
+}
+
+// await calls start on the UI thread and blocks until start has called done
+// or ctx is done. On the UI thread it keeps the message loop running while it
+// waits, as WebView2 delivers its completion callbacks through it.
+func (w *WebView) await(ctx context.Context, start func(done func())) error {
+	ch := make(chan struct{})
+	var once sync.Once
+	done := func() { once.Do(func() { close(ch) }) }
+
+	if !w.isMainThread() {
+		w.Dispatch(func() { start(done) })
+		select {
+		case <-ch:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	// A WM_APP wakes the loop up once the context is done.
+	finished := make(chan struct{})
+	defer close(finished)
+	go func() {
+		select {
+		case <-ctx.Done():
+			w32.User32PostThreadMessageW.Call(w.mainthread, w32.WMApp, 0, 0)
+		case <-finished:
+		}
+	}()
+	start(done)
+	for {
+		select {
+		case <-ch:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		if !w.pumpMessage() {
+			// Leave WM_QUIT for Run to pick up.
+			w32.User32PostQuitMessage.Call(0)
+			return errors.New("message loop terminated")
+		}
+	}
+}
+
+// EvalAsync evaluates js without blocking and calls cb on the UI thread with
+// the JSON-encoded result, or the error that kept it from being produced. cb
+// is called exactly once, with ErrDestroyed if the window goes away first.
+func (w *WebView) EvalAsync(js string, cb func(result string, err error)) {
+	var once sync.Once
+	w.m.Lock()
+	id := w.evalSeq
+	w.evalSeq++
+	w.pendingEvals[id] = func(result string, err error) {
+		once.Do(func() { cb(result, err) })
+	}
+	w.m.Unlock()
+
+	complete := func(result string, err error) {
+		w.Dispatch(func() {
+			w.m.Lock()
+			done, ok := w.pendingEvals[id]
+			delete(w.pendingEvals, id)
+			w.m.Unlock()
+			if ok {
+				done(result, err)
+			}
+		})
+	}
+	w.Dispatch(func() {
+		err := w.Browser.ExecuteScript(evalScript(js), func(res string, err error) {
+			if err == nil {
+				res, err = evalResult(res)
+			}
+			complete(res, err)
+		})
+		if err != nil {
+			complete("", err)
+		}
+	})
+}
+
+// failPendingEvals completes all outstanding EvalAsync callbacks with err.
+func (w *WebView) failPendingEvals(err error) {
+	w.m.Lock()
+	pending := w.pendingEvals
+	w.pendingEvals = map[int]func(string, error){}
+	w.m.Unlock()
+	for _, done := range pending {
+		done("", err)
+	}
+}
+
+// findScript selects the next match of a query with window.find, counts the
+// rendered matches in the page and the frames of its origin, and optionally
+// highlights them through the CSS Custom Highlight API.
+const findScript = `(function(query, caseSensitive, findNext, highlightAll) {
+	// fold lowers s for a case-insensitive search without changing the
+	// offsets of its characters, which toLowerCase alone may do.
+	function fold(s) {
+		if (caseSensitive) {
+			return s;
+		}
+		var lower = s.toLowerCase();
+		if (lower.length === s.length) {
+			return lower;
+		}
+		var folded = "";
+		for (var i = 0; i < s.length; i++) {
+			var c = s[i].toLowerCase();
+			folded += c.length === 1 ? c : s[i];
+		}
+		return folded;
+	}
+	function rendered(node) {
+		var el = node.parentElement;
+		if (!el || el.closest("script,style,noscript,template")) {
+			return false;
+		}
+		if (el.checkVisibility) {
+			return el.checkVisibility({visibilityProperty: true});
+		}
+		return el.getClientRects().length > 0;
+	}
+	var needle = fold(query);
+	var count = 0;
+	(function search(win) {
+		var doc = win.document;
+		var highlights = win.CSS && win.CSS.highlights;
+		if (highlights) {
+			highlights.delete("webview-find");
+		}
+		var root = doc.body || doc.documentElement;
+		var ranges = [];
+		if (needle && root) {
+			var walker = doc.createTreeWalker(root, NodeFilter.SHOW_TEXT, function(node) {
+				return rendered(node) ? NodeFilter.FILTER_ACCEPT : NodeFilter.FILTER_SKIP;
+			});
+			for (var node = walker.nextNode(); node; node = walker.nextNode()) {
+				var text = fold(node.data);
+				for (var i = text.indexOf(needle); i >= 0; i = text.indexOf(needle, i + needle.length)) {
+					count++;
+					if (highlightAll) {
+						var range = doc.createRange();
+						range.setStart(node, i);
+						range.setEnd(node, i + needle.length);
+						ranges.push(range);
+					}
+				}
+			}
+		}
+		if (highlights && ranges.length) {
+			if (!doc.getElementById("webview-find-style")) {
+				var style = doc.createElement("style");
+				style.id = "webview-find-style";
+				style.textContent = "::highlight(webview-find){background:#ff0;color:#000}";
+				(doc.head || doc.documentElement).appendChild(style);
+			}
+			highlights.set("webview-find", new win.Highlight(...ranges));
+		}
+		for (var f = 0; f < win.frames.length; f++) {
+			try {
+				search(win.frames[f]);
+			} catch (e) {
+				// Frames of other origins cannot be searched.
+			}
+		}
+	})(window);
+	if (!findNext) {
+		window.getSelection().removeAllRanges();
+	}
+	if (needle) {
+		window.find(query, caseSensitive, false, true);
+	}
+	return count;
+})`
+
+// stopFindScript undoes everything findScript left behind.
+const stopFindScript = `(function clear(win) {
+	try {
+		if (win.CSS && win.CSS.highlights) {
+			win.CSS.highlights.delete("webview-find");
+		}
+		var style = win.document.getElementById("webview-find-style");
+		if (style) {
+			style.remove();
+		}
+	} catch (e) {
+		return;
+	}
+	for (var f = 0; f < win.frames.length; f++) {
+		clear(win.frames[f]);
+	}
+	if (win === window) {
+		window.getSelection().removeAllRanges();
+	}
+})(window)`
+
+// FindInPage searches the page for query and selects the first match, or the
+// next one if options.FindNext is set. Only text that is rendered counts;
+// matches in frames of the same origin are counted and highlighted, but not
+// selected. The number of matches is available from GetFindMatchCount once
+// the search has finished.
+func (w *WebView) FindInPage(query string, options FindInPageOptions) {
+	js := findScript + "(" + jsString(query) + "," + jsString(options.CaseSensitive) + "," +
+		jsString(options.FindNext) + "," + jsString(options.ShouldHighlightAllMatches) + ")"
+	w.EvalAsync(js, func(result string, err error) {
+		count := 0
+		if err != nil {
+			log.Printf("FindInPage: %v", err)
+		} else {
+			json.Unmarshal([]byte(result), &count)
+		}
+		w.m.Lock()
+		w.findMatchCount = count
+		w.m.Unlock()
+	})
+}
+
+// StopFind ends the current FindInPage session and removes its selection and
+// highlights.
+func (w *WebView) StopFind() {
+	w.m.Lock()
+	w.findMatchCount = 0
+	w.m.Unlock()
+	w.EvalAsync(stopFindScript, func(string, error) {})
+}
+
+// GetFindMatchCount returns the number of matches found by the last
+// FindInPage search.
+func (w *WebView) GetFindMatchCount() int {
+	w.m.Lock()
+	defer w.m.Unlock()
+	return w.findMatchCount
+}
+
+// PostWebMessageAsJSON sends jsonStr to the page, where it arrives parsed as
+// the data of a message event on window.chrome.webview. It returns
+// ErrWebViewNotReady until the first navigation has completed.
+func (w *WebView) PostWebMessageAsJSON(jsonStr string) error {
+	if !json.Valid([]byte(jsonStr)) {
+		return errors.New("invalid JSON message")
+	}
+	return w.postWebMessage(func() error {
+		return w.Browser.PostWebMessageAsJSON(jsonStr)
+	})
+}
+
+// PostWebMessageAsString is like PostWebMessageAsJSON, but the page receives
+// str as is.
+func (w *WebView) PostWebMessageAsString(str string) error {
+	return w.postWebMessage(func() error {
+		return w.Browser.PostWebMessageAsString(str)
+	})
+}
+
+func (w *WebView) postWebMessage(post func() error) error {
+	w.m.Lock()
+	navigated := w.navigated
+	w.m.Unlock()
+	if !navigated {
+		return ErrWebViewNotReady
+	}
+	var err error
+	w.dispatchSync(func() {
+		err = post()
+	})
+	return err
+}
+
 func (w *WebView) Dispatch(f func()) {
+	w.enqueue(context.Background(), &f)
+}
+
+// DispatchContext is like Dispatch, but while the queue is full, as limited
+// by SetDispatchQueueMaxDepth, it waits for room only until ctx is done and
+// then returns ErrQueueFull without queueing f.
+func (w *WebView) DispatchContext(ctx context.Context, f func()) error {
+	return w.enqueue(ctx, &f)
+}
+
+// SetDispatchQueueMaxDepth limits the number of functions waiting to run on
+// the UI thread to max. Dispatch then blocks callers on other goroutines
+// while the queue is full; functions dispatched from the UI thread itself are
+// always queued. Zero, the default, leaves the queue unbounded.
+func (w *WebView) SetDispatchQueueMaxDepth(max int) {
+	w.m.Lock()
+	w.dispatchMax = max
+	w.signalDispatchRoom()
+	w.m.Unlock()
+}
+
+// enqueue adds f to the dispatch queue, waiting until ctx is done for room
+// while the queue is full.
+func (w *WebView) enqueue(ctx context.Context, f *func()) error {
 	w.m.Lock()
+	for w.dispatchMax > 0 && len(w.dispatchq) >= w.dispatchMax && !w.isMainThread() {
+		if w.dispatchRoom == nil {
+			w.dispatchRoom = make(chan struct{})
+		}
+		room := w.dispatchRoom
+		w.m.Unlock()
+		select {
+		case <-room:
+		case <-ctx.Done():
+			return ErrQueueFull
+		}
+		w.m.Lock()
+	}
 	w.dispatchq = append(w.dispatchq, f)
 	w.m.Unlock()
 	w32.User32PostThreadMessageW.Call(w.mainthread, w32.WMApp, 0, 0)
+	return nil
+}
+
+// signalDispatchRoom wakes up callers waiting for room in the dispatch queue.
+// w.m must be held.
+func (w *WebView) signalDispatchRoom() {
+	if w.dispatchRoom != nil {
+		close(w.dispatchRoom)
+		w.dispatchRoom = nil
+	}
+}
+
+// BatchDispatch queues funcs to run on the UI thread one after the other, in
+// order, without other dispatched functions running in between.
+func (w *WebView) BatchDispatch(funcs []func()) {
+	if len(funcs) == 0 {
+		return
+	}
+	funcs = append([]func(){}, funcs...)
+	batch := func() {
+		for _, f := range funcs {
+			f()
+		}
+	}
+	w.Dispatch(batch)
+}
+
+// DispatchSync runs f on the UI thread, like Dispatch, and waits until it has
+// returned. Called on the UI thread, it runs f right away.
+func (w *WebView) DispatchSync(f func()) error {
+	return w.DispatchSyncContext(context.Background(), f)
+}
+
+// DispatchSyncContext is like DispatchSync but stops waiting and returns
+// ErrDispatchTimeout once ctx is done. f then still runs when the UI thread
+// gets to it. If the dispatch queue stays full until ctx is done, f is not
+// queued and ErrQueueFull is returned, as with DispatchContext.
+func (w *WebView) DispatchSyncContext(ctx context.Context, f func()) error {
+	if w.isMainThread() {
+		f()
+		return nil
+	}
+	done := make(chan struct{})
+	run := func() {
+		defer close(done)
+		f()
+	}
+	if err := w.enqueue(ctx, &run); err != nil {
+		return err
+	}
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ErrDispatchTimeout
+	}
+}
+
+// DispatchWithDeadline queues f like Dispatch and waits until it has run. If
+// the UI thread has not started f by deadline, f is skipped and
+// ErrDispatchDeadlineExceeded is returned; f never starts after deadline. Called on the UI
+// thread, it runs f right away. If the dispatch queue stays full until
+// deadline, f is not queued and ErrQueueFull is returned, as with
+// DispatchContext.
+func (w *WebView) DispatchWithDeadline(f func(), deadline time.Time) error {
+	if w.isMainThread() {
+		f()
+		return nil
+	}
+	done := make(chan struct{})
+	expired := make(chan struct{})
+	run := func() {
+		// The queue may have been taken for running before the timer fired.
+		if !time.Now().Before(deadline) {
+			close(expired)
+			return
+		}
+		defer close(done)
+		f()
+	}
+	item := &run
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+	if err := w.enqueue(ctx, item); err != nil {
+		return err
+	}
+
+	timer := time.AfterFunc(time.Until(deadline), func() {
+		w.m.Lock()
+		defer w.m.Unlock()
+		for i, v := range w.dispatchq {
+			if v == item {
+				w.dispatchq = append(w.dispatchq[:i:i], w.dispatchq[i+1:]...)
+				w.signalDispatchRoom()
+				close(expired)
+				return
+			}
+		}
+	})
+	defer timer.Stop()
+	select {
+	case <-done:
+		return nil
+	case <-expired:
+		return ErrDispatchDeadlineExceeded
+	}
 }
 
 func (w *WebView) Bind(name string, f interface{}) error {
-	v := reflect.ValueOf(f)
+	return w.bind(name, binding{f: f})
+}
+
+// BindWithContext is like Bind, but calls are given up once ctx is done: calls
+// made after that, or still running then, are rejected with "binding context
+// cancelled". f runs on its own goroutine rather than the UI thread, and if
+// its first parameter is a context.Context it is passed ctx, so that it can
+// stop its work.
+func (w *WebView) BindWithContext(name string, f interface{}, ctx context.Context) error {
+	return w.bind(name, binding{f: f, ctx: ctx})
+}
+
+// BindAsync is like Bind for functions that deliver their result later: f
+// returns a channel, optionally along with an error, and the JavaScript
+// promise is settled with the first value received from it. A value that is
+// an error rejects the promise. The channel must either send exactly one
+// value or be closed, which rejects the promise. f is not called on the UI
+// thread.
+func (w *WebView) BindAsync(name string, f interface{}) error {
+	t := reflect.TypeOf(f)
+	if t == nil || t.Kind() != reflect.Func || t.NumOut() == 0 ||
+		t.Out(0).Kind() != reflect.Chan || t.Out(0).ChanDir()&reflect.RecvDir == 0 {
+		return errors.New("only functions returning a channel can be bound asynchronously")
+	}
+	return w.bind(name, binding{f: f, async: true})
+}
+
+// BindWithTimeout is like Bind, but calls that take longer than timeout are
+// rejected with "binding timeout after Xms". As with BindWithContext, f runs
+// on its own goroutine and is passed a context that is done at the deadline
+// if its first parameter is a context.Context.
+func (w *WebView) BindWithTimeout(name string, f interface{}, timeout time.Duration) error {
+	if timeout <= 0 {
+		return errors.New("timeout must be positive")
+	}
+	return w.bind(name, binding{f: f, timeout: timeout})
+}
+
+func (w *WebView) bind(name string, b binding) error {
+	v := reflect.ValueOf(b.f)
 	if v.Kind() != reflect.Func {
 		return errors.New("only functions can be bound")
 	}
@@ -362,15 +4911,16 @@ func (w *WebView) Bind(name string, f interface{}) error {
 		return errors.New("function may only return a value or a value+error")
 	}
 	w.m.Lock()
-	w.bindings[name] = f
+	w.bindings[name] = b
 	w.m.Unlock()
 
-	w.Init("(function() { var name = " + jsString(name) + ";" + `
+	w.Browser.AddScriptToExecuteOnDocumentCreated("(function() { var name = "+jsString(name)+";"+`
 		var RPC = window._rpc = (window._rpc || {nextSeq: 1});
 		window[name] = function() {
 		  var seq = RPC.nextSeq++;
 		  var promise = new Promise(function(resolve, reject) {
 			RPC[seq] = {
+			  method: name,
 			  resolve: resolve,
 			  reject: reject,
 			};
@@ -382,7 +4932,121 @@ func (w *WebView) Bind(name string, f interface{}) error {
 		  }));
 		  return promise;
 		}
-	})()`)
+	})()`, func(id string, err error) {
+		if err != nil {
+			log.Printf("binding %s: %v", name, err)
+			return
+		}
+		w.m.Lock()
+		_, bound := w.bindings[name]
+		old, replaced := w.bindingScripts[name]
+		if bound {
+			w.bindingScripts[name] = id
+		}
+		w.m.Unlock()
+		if !bound {
+			// Unbind was called before the script ID arrived.
+			w.Browser.RemoveScriptToExecuteOnDocumentCreated(id)
+		} else if replaced {
+			w.Browser.RemoveScriptToExecuteOnDocumentCreated(old)
+		}
+	})
+
+	return nil
+}
+
+// BindingMiddleware runs around every call of a bound function. It is passed
+// the name of the binding and the JSON arguments of the call, and calls next,
+// possibly with changed arguments, to go on to the next middleware and
+// finally the function. It may also return without calling next, such as to
+// reject a call.
+type BindingMiddleware func(name string, params []json.RawMessage, next func([]json.RawMessage) (interface{}, error)) (interface{}, error)
+
+// SetBindingMiddleware makes calls of bound functions go through mw, the
+// first one outermost. It replaces any previous middleware; calling it
+// without arguments removes it.
+func (w *WebView) SetBindingMiddleware(mw ...BindingMiddleware) {
+	mw = append([]BindingMiddleware(nil), mw...)
+	w.m.Lock()
+	w.middleware = mw
+	w.m.Unlock()
+}
+
+func (w *WebView) bindingPanicked(name string, panicValue interface{}) {
+	w.m.Lock()
+	handler := w.onBindingPanic
+	w.m.Unlock()
+	if handler == nil {
+		log.Printf("binding %s panicked: %v", name, panicValue)
+		return
+	}
+	w.Dispatch(func() {
+		handler(name, panicValue)
+	})
+}
+
+// OnBindingPanic sets a handler that is called from the dispatch queue when a
+// bound function, or a BindingMiddleware, panics. The panic is recovered and
+// the JavaScript promise rejected either way; without a handler the panic is
+// logged. It replaces any previous handler.
+func (w *WebView) OnBindingPanic(handler func(name string, panicValue interface{})) {
+	w.m.Lock()
+	w.onBindingPanic = handler
+	w.m.Unlock()
+}
 
+// SetGlobalRPCTimeout rejects calls of any bound function with "rpc timeout"
+// once they take longer than timeout; zero, the default, lets them run as
+// long as they need. While it is set, bound functions run on their own
+// goroutine. For bindings made with BindWithTimeout the shorter of the two
+// timeouts applies.
+func (w *WebView) SetGlobalRPCTimeout(timeout time.Duration) {
+	w.m.Lock()
+	w.rpcTimeout = timeout
+	w.m.Unlock()
+}
+
+// ListBindings returns the sorted names of the functions currently bound with
+// Bind or one of its variants.
+func (w *WebView) ListBindings() []string {
+	w.m.Lock()
+	names := make([]string, 0, len(w.bindings))
+	for name := range w.bindings {
+		names = append(names, name)
+	}
+	w.m.Unlock()
+	sort.Strings(names)
+	return names
+}
+
+// Unbind removes a function previously registered with Bind. The JavaScript
+// function is deleted from the current document and no longer injected into
+// new ones, and calls still waiting for a result are rejected.
+func (w *WebView) Unbind(name string) error {
+	w.m.Lock()
+	_, ok := w.bindings[name]
+	id, hasScript := w.bindingScripts[name]
+	delete(w.bindings, name)
+	delete(w.bindingScripts, name)
+	w.m.Unlock()
+	if !ok {
+		return errors.New("binding not found")
+	}
+
+	w.Dispatch(func() {
+		if hasScript {
+			w.Browser.RemoveScriptToExecuteOnDocumentCreated(id)
+		}
+		w.Eval("(function() { var name = " + jsString(name) + ";" + `
+			delete window[name];
+			var RPC = window._rpc || {};
+			for (var seq in RPC) {
+			  if (RPC[seq] && RPC[seq].method === name) {
+				RPC[seq].reject("binding removed");
+				RPC[seq] = undefined;
+			  }
+			}
+		})()`)
+	})
 	return nil
 }
@@ -0,0 +1,116 @@
+//go:build windows
+// +build windows
+
+package webview2
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestMatchFilter(t *testing.T) {
+	tests := []struct {
+		filter, uri string
+		want        bool
+	}{
+		{"https://example.com/", "https://example.com/", true},
+		{"https://example.com/", "https://example.com/a", false},
+		{"*", "", true},
+		{"*", "https://example.com/", true},
+		{"https://example.com/*", "https://example.com/", true},
+		{"https://example.com/*", "https://example.com/a/b", true},
+		{"https://example.com/*", "http://example.com/", false},
+		{"*.png", "https://example.com/a.png", true},
+		{"*.png", "https://example.com/a.png?x", false},
+		{"https://*/api/*", "https://example.com/api/users", true},
+		{"https://*/api/*", "https://example.com/web/users", false},
+		{"*a*a*", "aa", true},
+		{"*a*a*", "a", false},
+		{"a*a", "a", false},
+	}
+	for _, tt := range tests {
+		if got := matchFilter(tt.filter, tt.uri); got != tt.want {
+			t.Errorf("matchFilter(%q, %q) = %v, want %v", tt.filter, tt.uri, got, tt.want)
+		}
+	}
+}
+
+func TestInsertBase(t *testing.T) {
+	const base = `<base href="https://example.com/">`
+	tests := []struct {
+		content, want string
+	}{
+		{"", base},
+		{"<p>text</p>", base + "<p>text</p>"},
+		{"<!DOCTYPE html><p>text</p>", "<!DOCTYPE html>" + base + "<p>text</p>"},
+		{"\n <!doctype html>\n<p>text</p>", "\n <!doctype html>" + base + "\n<p>text</p>"},
+		{"<!DOCTYPE html><html><head><title>t</title></head></html>", "<!DOCTYPE html><html><head>" + base + "<title>t</title></head></html>"},
+		{`<HEAD lang="en"><title>t</title>`, `<HEAD lang="en">` + base + "<title>t</title>"},
+		{"<header>x</header>", base + "<header>x</header>"},
+		{"<header>x</header><head></head>", "<header>x</header><head>" + base + "</head>"},
+		{"<p>İ</p><head></head>", "<p>İ</p><head>" + base + "</head>"},
+	}
+	for _, tt := range tests {
+		if got := insertBase(tt.content, base); got != tt.want {
+			t.Errorf("insertBase(%q) = %q, want %q", tt.content, got, tt.want)
+		}
+	}
+}
+
+func TestEvalResult(t *testing.T) {
+	tests := []struct {
+		result  string
+		want    string
+		wantErr string
+	}{
+		{`[true,42]`, `42`, ""},
+		{`[true,{"a":[1,2]}]`, `{"a":[1,2]}`, ""},
+		{`[true,null]`, `null`, ""},
+		{`[false,"boom"]`, "", "javascript exception: boom"},
+		{`null`, "", "unexpected script result: null"},
+		{`[true]`, "", "unexpected script result: [true]"},
+		{`not json`, "", "unexpected script result: not json"},
+	}
+	for _, tt := range tests {
+		got, err := evalResult(tt.result)
+		if tt.wantErr != "" {
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("evalResult(%q) error = %v, want %q", tt.result, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("evalResult(%q) = %q, %v, want %q", tt.result, got, err, tt.want)
+		}
+	}
+}
+
+func TestParseRPC(t *testing.T) {
+	tests := []struct {
+		msg    string
+		want   rpcMessage
+		wantOK bool
+	}{
+		{`{"id":1,"method":"add","params":[1,"two"]}`, rpcMessage{
+			ID:     1,
+			Method: "add",
+			Params: []json.RawMessage{json.RawMessage(`1`), json.RawMessage(`"two"`)},
+		}, true},
+		{`{"id":2,"method":"noArgs","params":[]}`, rpcMessage{ID: 2, Method: "noArgs", Params: []json.RawMessage{}}, true},
+		{`{"id":3,"params":[]}`, rpcMessage{}, false},
+		{`"hello"`, rpcMessage{}, false},
+		{`hello`, rpcMessage{}, false},
+		{`{"type":"custom"}`, rpcMessage{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := parseRPC(tt.msg)
+		if ok != tt.wantOK {
+			t.Errorf("parseRPC(%q) ok = %v, want %v", tt.msg, ok, tt.wantOK)
+			continue
+		}
+		if ok && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseRPC(%q) = %+v, want %+v", tt.msg, got, tt.want)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package webviewloader
 import (
 	"fmt"
 	"sync"
+	"syscall"
 	"unsafe"
 
 	"github.com/jchv/go-winloader"
@@ -13,11 +14,13 @@ var (
 	nativeModule                 = windows.NewLazyDLL("WebView2Loader")
 	nativeCreate                 = nativeModule.NewProc("CreateCoreWebView2EnvironmentWithOptions")
 	nativeCompareBrowserVersions = nativeModule.NewProc("CompareBrowserVersions")
+	nativeGetVersionString       = nativeModule.NewProc("GetAvailableCoreWebView2BrowserVersionString")
 
 	memOnce                   sync.Once
 	memModule                 winloader.Module
 	memCreate                 winloader.Proc
 	memCompareBrowserVersions winloader.Proc
+	memGetVersionString       winloader.Proc
 	memErr                    error
 )
 
@@ -62,6 +65,50 @@ func CompareBrowserVersions(v1 string, v2 string) (int, error) {
 	return result, nil
 }
 
+// GetAvailableCoreWebView2BrowserVersionString returns the version of the
+// runtime in browserExecutableFolder, or of the installed runtime if it is
+// empty. The version is empty if no runtime was found.
+func GetAvailableCoreWebView2BrowserVersionString(browserExecutableFolder string) (string, error) {
+	var _browserExecutableFolder *uint16
+	if browserExecutableFolder != "" {
+		var err error
+		_browserExecutableFolder, err = windows.UTF16PtrFromString(browserExecutableFolder)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	nativeErr := nativeModule.Load()
+	if nativeErr == nil {
+		nativeErr = nativeGetVersionString.Find()
+	}
+	var _version *uint16
+	var res uintptr
+	if nativeErr != nil {
+		err := loadFromMemory(nativeErr)
+		if err != nil {
+			return "", fmt.Errorf("Unable to load WebView2Loader.dll from disk: %v -- or from memory: %w", nativeErr, memErr)
+		}
+		r, _, _ := memGetVersionString.Call(
+			uint64(uintptr(unsafe.Pointer(_browserExecutableFolder))),
+			uint64(uintptr(unsafe.Pointer(&_version))))
+		res = uintptr(r)
+	} else {
+		res, _, _ = nativeGetVersionString.Call(
+			uintptr(unsafe.Pointer(_browserExecutableFolder)),
+			uintptr(unsafe.Pointer(&_version)))
+	}
+	if int32(res) < 0 {
+		return "", syscall.Errno(res)
+	}
+	if _version == nil {
+		return "", nil
+	}
+	version := windows.UTF16PtrToString(_version)
+	windows.CoTaskMemFree(unsafe.Pointer(_version))
+	return version, nil
+}
+
 // CreateCoreWebView2EnvironmentWithOptions tries to load WebviewLoader2 and
 // call the CreateCoreWebView2EnvironmentWithOptions routine.
 func CreateCoreWebView2EnvironmentWithOptions(browserExecutableFolder, userDataFolder *uint16, environmentOptions uintptr, environmentCompletedHandle uintptr) (uintptr, error) {
@@ -102,6 +149,7 @@ func loadFromMemory(nativeErr error) error {
 		}
 		memCreate = memModule.Proc("CreateCoreWebView2EnvironmentWithOptions")
 		memCompareBrowserVersions = memModule.Proc("CompareBrowserVersions")
+		memGetVersionString = memModule.Proc("GetAvailableCoreWebView2BrowserVersionString")
 	})
 	return err
 }